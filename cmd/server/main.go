@@ -0,0 +1,141 @@
+// Command server starts the interfaceCapybara HTTP API.
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/MarkoAnn/interfaceCapybara/pkg/auth"
+	"github.com/MarkoAnn/interfaceCapybara/pkg/domain"
+	"github.com/MarkoAnn/interfaceCapybara/pkg/events"
+	"github.com/MarkoAnn/interfaceCapybara/pkg/handlers"
+	"github.com/MarkoAnn/interfaceCapybara/pkg/jobs"
+	"github.com/MarkoAnn/interfaceCapybara/pkg/repository"
+	"github.com/MarkoAnn/interfaceCapybara/pkg/ui"
+
+	// SQL drivers registered with database/sql; selected at runtime via
+	// SQL_DRIVER so repository.NewRepository stays driver-agnostic.
+	_ "github.com/lib/pq"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+const (
+	defaultTokenTTL   = 24 * time.Hour
+	defaultJobWorkers = 4
+)
+
+// configFromEnv builds a repository.Config from environment variables,
+// defaulting to the in-memory backend when REPO_BACKEND is unset.
+func configFromEnv() repository.Config {
+	redisDB, _ := strconv.Atoi(os.Getenv("REDIS_DB"))
+	return repository.Config{
+		Backend: repository.Backend(os.Getenv("REPO_BACKEND")),
+
+		SQLDriver: os.Getenv("SQL_DRIVER"),
+		SQLDSN:    os.Getenv("SQL_DSN"),
+
+		BoltPath: os.Getenv("BOLT_PATH"),
+
+		RedisAddr:     os.Getenv("REDIS_ADDR"),
+		RedisPassword: os.Getenv("REDIS_PASSWORD"),
+		RedisDB:       redisDB,
+	}
+}
+
+// seedAdminUser creates the bootstrap admin account named by ADMIN_ID on
+// first startup, so there's at least one user who can reach the
+// admin-gated routes (GET /users, DELETE /users/{id}, /jobs, /events,
+// GET /ui/users). It is
+// a no-op once that account exists, so it's safe to leave set across
+// restarts.
+func seedAdminUser(repo domain.UserRepository, id, password string) error {
+	if id == "" || password == "" {
+		return nil
+	}
+	if _, err := repo.Find(id); err == nil {
+		return nil
+	} else if !errors.Is(err, domain.ErrUserNotFound) {
+		return fmt.Errorf("seed admin user: %w", err)
+	}
+
+	passwordHash, err := auth.HashPassword(password)
+	if err != nil {
+		return fmt.Errorf("seed admin user: %w", err)
+	}
+	return repo.Create(domain.User{
+		ID:           id,
+		Name:         id,
+		Role:         domain.RoleAdmin,
+		PasswordHash: passwordHash,
+	})
+}
+
+func main() {
+	userRepository, err := repository.NewRepository(configFromEnv())
+	if err != nil {
+		fmt.Println("failed to initialize repository:", err)
+		os.Exit(1)
+	}
+
+	eventBus := events.NewBus()
+	userRepository = repository.NewPublishingUserRepository(userRepository, eventBus)
+
+	if err := seedAdminUser(userRepository, os.Getenv("ADMIN_ID"), os.Getenv("ADMIN_PASSWORD")); err != nil {
+		fmt.Println("failed to seed admin user:", err)
+		os.Exit(1)
+	}
+
+	jwtSecret := os.Getenv("AUTH_JWT_SECRET")
+	if jwtSecret == "" {
+		fmt.Println("AUTH_JWT_SECRET is not set; refusing to start with an empty signing key")
+		os.Exit(1)
+	}
+	tokens := auth.NewTokenManager([]byte(jwtSecret), defaultTokenTTL)
+	sessions := auth.NewInMemorySessionStore()
+
+	jobRepository := jobs.NewInMemoryJobRepository()
+	jobPool := jobs.NewPool(jobRepository, defaultJobWorkers)
+	jobPool.RegisterHandler(jobs.TypeBulkImportUsers, jobs.BulkImportUsersHandler(userRepository))
+	jobPool.RegisterHandler(jobs.TypeBulkExportUsers, jobs.BulkExportUsersHandler(userRepository))
+	jobPool.RegisterHandler(jobs.TypeSendWelcomeEmail, jobs.SendWelcomeEmailHandler(userRepository))
+	scheduler := jobs.NewScheduler(jobPool)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	jobPool.Start(ctx)
+	scheduler.Start()
+	defer scheduler.Stop()
+
+	apiRouter := handlers.NewRouter(handlers.Deps{
+		Users:     userRepository,
+		Tokens:    tokens,
+		Sessions:  sessions,
+		Jobs:      jobRepository,
+		JobPool:   jobPool,
+		Scheduler: scheduler,
+		Events:    eventBus,
+	})
+
+	router := chi.NewRouter()
+	router.Mount("/", apiRouter)
+
+	if os.Getenv("ENABLE_UI") == "true" {
+		csrfKey := os.Getenv("AUTH_CSRF_KEY")
+		if len(csrfKey) < 32 {
+			fmt.Println("AUTH_CSRF_KEY must be set to at least 32 bytes when ENABLE_UI=true")
+			os.Exit(1)
+		}
+		uiRouter := ui.NewRouter(userRepository, tokens, sessions, []byte(csrfKey))
+		router.Mount("/ui", uiRouter)
+	}
+
+	fmt.Println("Server is running on http://localhost:8080")
+	http.ListenAndServe(":8080", router)
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/MarkoAnn/interfaceCapybara/pkg/auth"
+	"github.com/MarkoAnn/interfaceCapybara/pkg/domain"
+	"github.com/MarkoAnn/interfaceCapybara/pkg/repository"
+)
+
+func TestSeedAdminUser(t *testing.T) {
+	repo := repository.NewInMemoryUserRepository()
+
+	if err := seedAdminUser(repo, "admin", "hunter2"); err != nil {
+		t.Fatalf("seedAdminUser() error = %v", err)
+	}
+
+	admin, err := repo.Find("admin")
+	if err != nil {
+		t.Fatalf("Find(admin) error = %v", err)
+	}
+	if admin.Role != domain.RoleAdmin {
+		t.Fatalf("admin.Role = %q, want %q", admin.Role, domain.RoleAdmin)
+	}
+	if err := auth.ComparePassword(admin.PasswordHash, "hunter2"); err != nil {
+		t.Fatalf("ComparePassword() error = %v, want seeded password to match", err)
+	}
+
+	if err := seedAdminUser(repo, "admin", "different-password"); err != nil {
+		t.Fatalf("seedAdminUser() on existing account error = %v", err)
+	}
+	unchanged, err := repo.Find("admin")
+	if err != nil {
+		t.Fatalf("Find(admin) error = %v", err)
+	}
+	if unchanged.PasswordHash != admin.PasswordHash {
+		t.Fatalf("seedAdminUser() overwrote an existing admin account's password hash")
+	}
+}
+
+func TestSeedAdminUser_NoopWithoutConfig(t *testing.T) {
+	repo := repository.NewInMemoryUserRepository()
+
+	if err := seedAdminUser(repo, "", ""); err != nil {
+		t.Fatalf("seedAdminUser() error = %v", err)
+	}
+	if _, err := repo.Find("admin"); err == nil {
+		t.Fatalf("seedAdminUser() created a user with no ADMIN_ID/ADMIN_PASSWORD set")
+	}
+}
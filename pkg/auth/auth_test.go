@@ -0,0 +1,90 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MarkoAnn/interfaceCapybara/pkg/domain"
+)
+
+func TestTokenManager_IssueAndParse(t *testing.T) {
+	manager := NewTokenManager([]byte("secret"), time.Hour)
+
+	token, _, err := manager.Issue("alice", domain.RoleAdmin)
+	if err != nil {
+		t.Fatalf("Issue() error = %v", err)
+	}
+
+	claims, err := manager.Parse(token)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if claims.UserID != "alice" || claims.Role != domain.RoleAdmin {
+		t.Fatalf("Parse() claims = %+v, want UserID=alice Role=admin", claims)
+	}
+}
+
+func TestTokenManager_ParseRejectsWrongSecret(t *testing.T) {
+	token, _, err := NewTokenManager([]byte("secret-a"), time.Hour).Issue("alice", domain.RoleUser)
+	if err != nil {
+		t.Fatalf("Issue() error = %v", err)
+	}
+
+	if _, err := NewTokenManager([]byte("secret-b"), time.Hour).Parse(token); err == nil {
+		t.Fatal("Parse() error = nil, want error for mismatched secret")
+	}
+}
+
+func TestTokenManager_ParseRejectsExpiredToken(t *testing.T) {
+	manager := NewTokenManager([]byte("secret"), -time.Minute)
+	token, _, err := manager.Issue("alice", domain.RoleUser)
+	if err != nil {
+		t.Fatalf("Issue() error = %v", err)
+	}
+
+	if _, err := manager.Parse(token); err == nil {
+		t.Fatal("Parse() error = nil, want error for expired token")
+	}
+}
+
+func TestPasswordHashing(t *testing.T) {
+	hash, err := HashPassword("hunter2")
+	if err != nil {
+		t.Fatalf("HashPassword() error = %v", err)
+	}
+	if err := ComparePassword(hash, "hunter2"); err != nil {
+		t.Fatalf("ComparePassword() error = %v, want nil", err)
+	}
+	if err := ComparePassword(hash, "wrong"); err == nil {
+		t.Fatal("ComparePassword() error = nil, want error for wrong password")
+	}
+}
+
+func TestInMemorySessionStore(t *testing.T) {
+	store := NewInMemorySessionStore()
+	session := Session{Token: "tok", UserID: "alice", Role: domain.RoleUser, ExpiresAt: time.Now().Add(time.Hour)}
+
+	if err := store.Create(session); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if _, err := store.Get("tok"); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if err := store.Revoke("tok"); err != nil {
+		t.Fatalf("Revoke() error = %v", err)
+	}
+	if _, err := store.Get("tok"); err != ErrSessionNotFound {
+		t.Fatalf("Get() after revoke error = %v, want ErrSessionNotFound", err)
+	}
+}
+
+func TestInMemorySessionStore_ExpiredSessionNotFound(t *testing.T) {
+	store := NewInMemorySessionStore()
+	session := Session{Token: "tok", UserID: "alice", Role: domain.RoleUser, ExpiresAt: time.Now().Add(-time.Minute)}
+	if err := store.Create(session); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if _, err := store.Get("tok"); err != ErrSessionNotFound {
+		t.Fatalf("Get() error = %v, want ErrSessionNotFound", err)
+	}
+}
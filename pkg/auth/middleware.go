@@ -0,0 +1,97 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/MarkoAnn/interfaceCapybara/pkg/domain"
+)
+
+type contextKey string
+
+const identityContextKey contextKey = "auth.identity"
+
+// Identity is the authenticated caller populated onto the request context by
+// RequireAuth.
+type Identity struct {
+	UserID string
+	Role   domain.Role
+}
+
+// RequireAuth validates the bearer token on every request, rejecting it
+// unless it is both a valid JWT and an unrevoked Session, and populates the
+// caller's Identity on the request context for downstream handlers.
+func RequireAuth(tokens *TokenManager, sessions SessionStore) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			tokenString, ok := bearerToken(r)
+			if !ok {
+				http.Error(w, "missing bearer token", http.StatusUnauthorized)
+				return
+			}
+
+			identity, err := ValidateToken(tokens, sessions, tokenString)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusUnauthorized)
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(WithIdentity(r.Context(), identity)))
+		})
+	}
+}
+
+// ValidateToken parses tokenString and checks it against an unrevoked
+// Session, returning the caller's Identity. It is exported so other
+// transports (e.g. the cookie-based session used by pkg/ui) can reuse the
+// same validation RequireAuth applies to bearer tokens.
+func ValidateToken(tokens *TokenManager, sessions SessionStore, tokenString string) (Identity, error) {
+	claims, err := tokens.Parse(tokenString)
+	if err != nil {
+		return Identity{}, errors.New("invalid token")
+	}
+	if _, err := sessions.Get(tokenString); err != nil {
+		return Identity{}, errors.New("session revoked or expired")
+	}
+	return Identity{UserID: claims.UserID, Role: claims.Role}, nil
+}
+
+// WithIdentity returns a copy of ctx carrying identity, retrievable later
+// with IdentityFromContext.
+func WithIdentity(ctx context.Context, identity Identity) context.Context {
+	return context.WithValue(ctx, identityContextKey, identity)
+}
+
+// RequireAdmin rejects any caller whose Identity is not domain.RoleAdmin. It
+// must run after RequireAuth.
+func RequireAdmin(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		identity, ok := IdentityFromContext(r.Context())
+		if !ok || identity.Role != domain.RoleAdmin {
+			http.Error(w, "admin role required", http.StatusForbidden)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// IdentityFromContext returns the Identity populated by RequireAuth, if any.
+func IdentityFromContext(ctx context.Context) (Identity, bool) {
+	identity, ok := ctx.Value(identityContextKey).(Identity)
+	return identity, ok
+}
+
+func bearerToken(r *http.Request) (string, bool) {
+	header := r.Header.Get("Authorization")
+	const prefix = "Bearer "
+	if !strings.HasPrefix(header, prefix) {
+		return "", false
+	}
+	token := strings.TrimPrefix(header, prefix)
+	if token == "" {
+		return "", false
+	}
+	return token, true
+}
@@ -0,0 +1,72 @@
+package auth
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/MarkoAnn/interfaceCapybara/pkg/domain"
+)
+
+var ErrSessionNotFound = errors.New("session not found")
+
+// Session is a live, revocable connection between a user and an issued
+// token. Unlike the JWT's own expiry, a Session can be invalidated
+// immediately (logout, password change) without waiting for the token to
+// expire on its own.
+type Session struct {
+	Token     string
+	UserID    string
+	Role      domain.Role
+	ExpiresAt time.Time
+}
+
+// SessionStore tracks issued sessions so tokens can be revoked independently
+// of their JWT signature. It has no refresh operation.
+type SessionStore interface {
+	Create(session Session) error
+	Get(token string) (Session, error)
+	Revoke(token string) error
+}
+
+// InMemorySessionStore is a SessionStore backed by a map; sessions do not
+// survive a process restart.
+type InMemorySessionStore struct {
+	mu       sync.Mutex
+	sessions map[string]Session
+}
+
+func NewInMemorySessionStore() *InMemorySessionStore {
+	return &InMemorySessionStore{sessions: make(map[string]Session)}
+}
+
+func (s *InMemorySessionStore) Create(session Session) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sessions[session.Token] = session
+	return nil
+}
+
+func (s *InMemorySessionStore) Get(token string) (Session, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	session, ok := s.sessions[token]
+	if !ok {
+		return Session{}, ErrSessionNotFound
+	}
+	if time.Now().After(session.ExpiresAt) {
+		delete(s.sessions, token)
+		return Session{}, ErrSessionNotFound
+	}
+	return session, nil
+}
+
+func (s *InMemorySessionStore) Revoke(token string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.sessions[token]; !ok {
+		return ErrSessionNotFound
+	}
+	delete(s.sessions, token)
+	return nil
+}
@@ -0,0 +1,66 @@
+// Package auth issues and validates JWT bearer tokens for the user CRUD API
+// and provides the middleware that enforces them.
+package auth
+
+import (
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/MarkoAnn/interfaceCapybara/pkg/domain"
+)
+
+var ErrInvalidToken = errors.New("invalid token")
+
+// Claims is the JWT payload identifying the caller and their role.
+type Claims struct {
+	UserID string      `json:"sub"`
+	Role   domain.Role `json:"role"`
+	jwt.RegisteredClaims
+}
+
+// TokenManager issues and parses signed JWTs. The zero value is not usable;
+// construct one with NewTokenManager.
+type TokenManager struct {
+	secret []byte
+	ttl    time.Duration
+}
+
+func NewTokenManager(secret []byte, ttl time.Duration) *TokenManager {
+	return &TokenManager{secret: secret, ttl: ttl}
+}
+
+// Issue mints a signed token for the given user, valid for the manager's TTL.
+func (m *TokenManager) Issue(userID string, role domain.Role) (string, time.Time, error) {
+	expiresAt := time.Now().Add(m.ttl)
+	claims := Claims{
+		UserID: userID,
+		Role:   role,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+		},
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	signed, err := token.SignedString(m.secret)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return signed, expiresAt, nil
+}
+
+// Parse validates a signed token and returns its claims.
+func (m *TokenManager) Parse(tokenString string) (*Claims, error) {
+	claims := &Claims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(t *jwt.Token) (interface{}, error) {
+		return m.secret, nil
+	})
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+	if !token.Valid {
+		return nil, ErrInvalidToken
+	}
+	return claims, nil
+}
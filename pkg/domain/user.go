@@ -0,0 +1,43 @@
+// Package domain holds the core entities and repository contracts shared by
+// every other package in the module. It has no dependencies on transport or
+// storage concerns so it can be imported freely from handlers, repository
+// implementations, and background jobs alike.
+package domain
+
+import "errors"
+
+// Sentinel errors returned by UserRepository implementations. Callers should
+// compare against these with errors.Is rather than matching on error text,
+// so that a backend remains free to wrap them with its own context.
+var (
+	ErrUserNotFound = errors.New("user not found")
+	ErrUserExists   = errors.New("user already exists")
+)
+
+// Role is the authorization level attached to a User. It gates access in
+// the auth middleware: admins may act on any user, while a plain Role can
+// only read or update its own record.
+type Role string
+
+const (
+	RoleAdmin Role = "admin"
+	RoleUser  Role = "user"
+)
+
+// User представляет сущность пользователя
+type User struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	Age          int    `json:"age"`
+	Role         Role   `json:"role"`
+	PasswordHash string `json:"-"`
+}
+
+// UserRepository определяет методы для работы с пользователями
+type UserRepository interface {
+	Create(user User) error
+	List() ([]User, error)
+	Find(id string) (User, error)
+	Update(user User) error
+	Delete(id string) error
+}
@@ -0,0 +1,101 @@
+// Package events implements a small in-process publish/subscribe bus used
+// to fan out user CRUD events to WebSocket clients.
+package events
+
+import "sync"
+
+// Event is a single notification published on the Bus.
+type Event struct {
+	Topic   string      `json:"topic"`
+	Payload interface{} `json:"payload"`
+}
+
+// Topics published by repository.PublishingUserRepository.
+const (
+	TopicUserCreated = "user.created"
+	TopicUserUpdated = "user.updated"
+	TopicUserDeleted = "user.deleted"
+)
+
+// Subscription receives Events matching the topic filter it was created
+// with. Callers must Unsubscribe when done to free the Bus's reference to
+// it and stop the channel from filling up.
+type Subscription struct {
+	ch     chan Event
+	topics map[string]struct{}
+}
+
+// Events returns the channel Events are delivered on. It is closed once the
+// Bus unsubscribes it.
+func (s *Subscription) Events() <-chan Event {
+	return s.ch
+}
+
+func (s *Subscription) matches(topic string) bool {
+	if len(s.topics) == 0 {
+		return true
+	}
+	_, ok := s.topics[topic]
+	return ok
+}
+
+// Bus fans out published Events to every matching Subscription. The zero
+// value is not usable; construct one with NewBus.
+type Bus struct {
+	mu   sync.Mutex
+	subs map[*Subscription]struct{}
+}
+
+func NewBus() *Bus {
+	return &Bus{subs: make(map[*Subscription]struct{})}
+}
+
+// Subscribe registers a new Subscription that only receives Events whose
+// Topic is in topics, or every Event if topics is empty.
+func (b *Bus) Subscribe(topics ...string) *Subscription {
+	topicSet := make(map[string]struct{}, len(topics))
+	for _, topic := range topics {
+		topicSet[topic] = struct{}{}
+	}
+
+	sub := &Subscription{ch: make(chan Event, 16), topics: topicSet}
+	b.mu.Lock()
+	b.subs[sub] = struct{}{}
+	b.mu.Unlock()
+	return sub
+}
+
+// Unsubscribe removes sub from the Bus and closes its channel. It is safe
+// to call more than once.
+func (b *Bus) Unsubscribe(sub *Subscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if _, ok := b.subs[sub]; ok {
+		delete(b.subs, sub)
+		close(sub.ch)
+	}
+}
+
+// SubscriberCount reports how many Subscriptions are currently registered.
+func (b *Bus) SubscriberCount() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.subs)
+}
+
+// Publish fans event out to every Subscription whose filter matches. A
+// subscriber whose channel is full is skipped rather than blocking the
+// publisher.
+func (b *Bus) Publish(event Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for sub := range b.subs {
+		if !sub.matches(event.Topic) {
+			continue
+		}
+		select {
+		case sub.ch <- event:
+		default:
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package events
+
+import "testing"
+
+func TestBus_PublishMatchesSubscribedTopic(t *testing.T) {
+	bus := NewBus()
+	sub := bus.Subscribe(TopicUserCreated)
+	defer bus.Unsubscribe(sub)
+
+	bus.Publish(Event{Topic: TopicUserDeleted})
+	bus.Publish(Event{Topic: TopicUserCreated, Payload: "alice"})
+
+	select {
+	case event := <-sub.Events():
+		if event.Topic != TopicUserCreated || event.Payload != "alice" {
+			t.Fatalf("Events() = %+v, want TopicUserCreated/alice", event)
+		}
+	default:
+		t.Fatal("Events() had no pending event, want the matching publish to be delivered")
+	}
+
+	select {
+	case event, ok := <-sub.Events():
+		if ok {
+			t.Fatalf("Events() delivered unexpected event %+v", event)
+		}
+	default:
+	}
+}
+
+func TestBus_SubscribeWithNoTopicsReceivesEverything(t *testing.T) {
+	bus := NewBus()
+	sub := bus.Subscribe()
+	defer bus.Unsubscribe(sub)
+
+	bus.Publish(Event{Topic: TopicUserUpdated})
+
+	select {
+	case event := <-sub.Events():
+		if event.Topic != TopicUserUpdated {
+			t.Fatalf("Events() = %+v, want TopicUserUpdated", event)
+		}
+	default:
+		t.Fatal("Events() had no pending event")
+	}
+}
+
+func TestBus_UnsubscribeClosesChannel(t *testing.T) {
+	bus := NewBus()
+	sub := bus.Subscribe()
+	bus.Unsubscribe(sub)
+
+	_, ok := <-sub.Events()
+	if ok {
+		t.Fatal("Events() open after Unsubscribe, want closed channel")
+	}
+}
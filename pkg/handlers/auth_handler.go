@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/MarkoAnn/interfaceCapybara/pkg/auth"
+	"github.com/MarkoAnn/interfaceCapybara/pkg/domain"
+)
+
+type loginRequest struct {
+	ID       string `json:"id"`
+	Password string `json:"password"`
+}
+
+type loginResponse struct {
+	Token     string `json:"token"`
+	ExpiresAt string `json:"expires_at"`
+}
+
+// LoginHandler verifies credentials against repo, and on success issues a
+// JWT via tokens and records it in sessions so it can later be revoked.
+func LoginHandler(repo domain.UserRepository, tokens *auth.TokenManager, sessions auth.SessionStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req loginRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, http.StatusBadRequest, "invalid request body")
+			return
+		}
+
+		user, err := repo.Find(req.ID)
+		if err != nil {
+			if errors.Is(err, domain.ErrUserNotFound) {
+				writeError(w, http.StatusUnauthorized, "invalid credentials")
+				return
+			}
+			writeRepoError(w, err)
+			return
+		}
+
+		if err := auth.ComparePassword(user.PasswordHash, req.Password); err != nil {
+			writeError(w, http.StatusUnauthorized, "invalid credentials")
+			return
+		}
+
+		token, expiresAt, err := tokens.Issue(user.ID, user.Role)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, "failed to issue token")
+			return
+		}
+
+		if err := sessions.Create(auth.Session{
+			Token:     token,
+			UserID:    user.ID,
+			Role:      user.Role,
+			ExpiresAt: expiresAt,
+		}); err != nil {
+			writeError(w, http.StatusInternalServerError, "failed to create session")
+			return
+		}
+
+		writeJSON(w, http.StatusOK, loginResponse{
+			Token:     token,
+			ExpiresAt: expiresAt.Format(time.RFC3339),
+		})
+	}
+}
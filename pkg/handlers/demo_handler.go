@@ -0,0 +1,18 @@
+package handlers
+
+import (
+	_ "embed"
+	"net/http"
+)
+
+//go:embed static/events_demo.html
+var eventsDemoPage []byte
+
+// EventsDemoPageHandler serves the small HTML/JS page that subscribes to
+// /events and renders live user events as they arrive.
+func EventsDemoPageHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Write(eventsDemoPage)
+	}
+}
@@ -0,0 +1,87 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/MarkoAnn/interfaceCapybara/pkg/auth"
+	"github.com/MarkoAnn/interfaceCapybara/pkg/domain"
+	"github.com/MarkoAnn/interfaceCapybara/pkg/events"
+)
+
+// eventsUpgrader leaves CheckOrigin unset so websocket.Upgrader falls back
+// to its safe default: reject the handshake if the Origin header is present
+// and doesn't match the request Host, so an arbitrary cross-origin page
+// can't open a subscription against a logged-in user's browser.
+var eventsUpgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+}
+
+// EventsHandler upgrades the request to a WebSocket and streams bus Events
+// matching ?topics=a,b,c (every event, if omitted) until the client
+// disconnects, at which point its Subscription is cleanly unsubscribed. The
+// caller must be an authenticated domain.RoleAdmin, since events carry user
+// data (name, age, role) for every account in the system - the same
+// restriction GET /users and /jobs carry. The browser WebSocket API can't
+// set an Authorization header on the handshake request, so unlike the rest
+// of the API the token is read from ?token= instead; auth.ValidateToken
+// applies the same JWT + session checks auth.RequireAuth does for bearer
+// tokens.
+func EventsHandler(bus *events.Bus, tokens *auth.TokenManager, sessions auth.SessionStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		identity, err := auth.ValidateToken(tokens, sessions, r.URL.Query().Get("token"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		if identity.Role != domain.RoleAdmin {
+			http.Error(w, "admin role required", http.StatusForbidden)
+			return
+		}
+
+		conn, err := eventsUpgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		var topics []string
+		if raw := r.URL.Query().Get("topics"); raw != "" {
+			topics = strings.Split(raw, ",")
+		}
+
+		sub := bus.Subscribe(topics...)
+		defer bus.Unsubscribe(sub)
+
+		// r.Context() is not canceled when a hijacked connection drops, so a
+		// read loop is what notices the client going away.
+		closed := make(chan struct{})
+		go func() {
+			defer close(closed)
+			for {
+				if _, _, err := conn.NextReader(); err != nil {
+					return
+				}
+			}
+		}()
+
+		for {
+			select {
+			case <-closed:
+				return
+			case event, ok := <-sub.Events():
+				if !ok {
+					return
+				}
+				if err := conn.WriteJSON(event); err != nil {
+					return
+				}
+			case <-r.Context().Done():
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/MarkoAnn/interfaceCapybara/pkg/auth"
+	"github.com/MarkoAnn/interfaceCapybara/pkg/domain"
+	"github.com/MarkoAnn/interfaceCapybara/pkg/events"
+)
+
+func TestEventsHandler_StreamsMatchingTopic(t *testing.T) {
+	bus := events.NewBus()
+	tokens := auth.NewTokenManager([]byte("test-secret"), time.Hour)
+	sessions := auth.NewInMemorySessionStore()
+	server := httptest.NewServer(EventsHandler(bus, tokens, sessions))
+	defer server.Close()
+
+	token := bearer(t, tokens, sessions, "admin", domain.RoleAdmin)
+	wsURL := "ws" + strings.TrimPrefix(server.URL, "http") + "?topics=" + events.TopicUserCreated + "&token=" + url.QueryEscape(token)
+	conn, _, err := websocket.DefaultDialer.Dial(wsURL, nil)
+	if err != nil {
+		t.Fatalf("Dial() error = %v", err)
+	}
+	defer conn.Close()
+
+	// Give the handler a moment to register its subscription before
+	// publishing, since Subscribe happens after the WS handshake completes.
+	deadline := time.Now().Add(time.Second)
+	for bus.SubscriberCount() == 0 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+
+	bus.Publish(events.Event{Topic: events.TopicUserDeleted, Payload: "ignored"})
+	bus.Publish(events.Event{Topic: events.TopicUserCreated, Payload: "alice"})
+
+	conn.SetReadDeadline(time.Now().Add(time.Second))
+	var got events.Event
+	if err := conn.ReadJSON(&got); err != nil {
+		t.Fatalf("ReadJSON() error = %v", err)
+	}
+	if got.Topic != events.TopicUserCreated || got.Payload != "alice" {
+		t.Fatalf("ReadJSON() = %+v, want topic=%q payload=alice", got, events.TopicUserCreated)
+	}
+}
+
+func TestEventsHandler_RejectsMissingToken(t *testing.T) {
+	bus := events.NewBus()
+	tokens := auth.NewTokenManager([]byte("test-secret"), time.Hour)
+	sessions := auth.NewInMemorySessionStore()
+	server := httptest.NewServer(EventsHandler(bus, tokens, sessions))
+	defer server.Close()
+
+	wsURL := "ws" + strings.TrimPrefix(server.URL, "http") + "?topics=" + events.TopicUserCreated
+	_, resp, err := websocket.DefaultDialer.Dial(wsURL, nil)
+	if err == nil {
+		t.Fatalf("Dial() succeeded without a token, want an error")
+	}
+	if resp == nil || resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("Dial() response = %+v, want status %d", resp, http.StatusUnauthorized)
+	}
+}
+
+func TestEventsHandler_ForbidsNonAdmin(t *testing.T) {
+	bus := events.NewBus()
+	tokens := auth.NewTokenManager([]byte("test-secret"), time.Hour)
+	sessions := auth.NewInMemorySessionStore()
+	server := httptest.NewServer(EventsHandler(bus, tokens, sessions))
+	defer server.Close()
+
+	token := bearer(t, tokens, sessions, "alice", domain.RoleUser)
+	wsURL := "ws" + strings.TrimPrefix(server.URL, "http") + "?token=" + url.QueryEscape(token)
+	_, resp, err := websocket.DefaultDialer.Dial(wsURL, nil)
+	if err == nil {
+		t.Fatalf("Dial() succeeded for a non-admin token, want an error")
+	}
+	if resp == nil || resp.StatusCode != http.StatusForbidden {
+		t.Fatalf("Dial() response = %+v, want status %d", resp, http.StatusForbidden)
+	}
+}
+
+func TestEventsDemoPageHandler_ServesHTML(t *testing.T) {
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	EventsDemoPageHandler()(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if !strings.Contains(rec.Body.String(), "WebSocket") {
+		t.Fatalf("body does not reference WebSocket: %s", rec.Body.String())
+	}
+}
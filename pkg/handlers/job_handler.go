@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/MarkoAnn/interfaceCapybara/pkg/jobs"
+)
+
+type createJobRequest struct {
+	Type    string          `json:"type"`
+	Params  json.RawMessage `json:"params"`
+	CronStr string          `json:"cron_str"`
+}
+
+// CreateJobHandler submits a new job. A one-off job is enqueued on pool
+// immediately; a job with cron_str set is instead registered on scheduler
+// and run on each tick.
+func CreateJobHandler(repo jobs.JobRepository, pool *jobs.Pool, scheduler *jobs.Scheduler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req createJobRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, http.StatusBadRequest, "invalid request body")
+			return
+		}
+		if req.Type == "" {
+			writeError(w, http.StatusBadRequest, "type is required")
+			return
+		}
+
+		job := jobs.Job{
+			ID:      jobs.NewID(),
+			Type:    req.Type,
+			Params:  req.Params,
+			CronStr: req.CronStr,
+		}
+
+		if job.CronStr != "" {
+			if _, err := scheduler.Schedule(job); err != nil {
+				writeError(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			if err := repo.Create(job); err != nil {
+				writeError(w, http.StatusInternalServerError, err.Error())
+				return
+			}
+			writeJSON(w, http.StatusAccepted, job)
+			return
+		}
+
+		if err := pool.Enqueue(job); err != nil {
+			writeError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		writeJSON(w, http.StatusAccepted, job)
+	}
+}
+
+func GetJobHandler(repo jobs.JobRepository) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id := chi.URLParam(r, "id")
+
+		job, err := repo.Find(id)
+		if err != nil {
+			if errors.Is(err, jobs.ErrJobNotFound) {
+				writeError(w, http.StatusNotFound, err.Error())
+				return
+			}
+			writeError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		writeJSON(w, http.StatusOK, job)
+	}
+}
+
+func ListJobsHandler(repo jobs.JobRepository) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		list, err := repo.List()
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		writeJSON(w, http.StatusOK, list)
+	}
+}
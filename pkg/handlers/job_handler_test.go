@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/MarkoAnn/interfaceCapybara/pkg/domain"
+	"github.com/MarkoAnn/interfaceCapybara/pkg/jobs"
+	"github.com/MarkoAnn/interfaceCapybara/pkg/repository"
+)
+
+func TestJobRoutes_CreateAndPoll(t *testing.T) {
+	userRepo := repository.NewInMemoryUserRepository()
+	router, tokens, sessions := newTestRouter(t, userRepo)
+	token := bearer(t, tokens, sessions, "admin", domain.RoleAdmin)
+
+	body, _ := json.Marshal(createJobRequest{
+		Type:   jobs.TypeBulkExportUsers,
+		Params: json.RawMessage(`{}`),
+	})
+	req := httptest.NewRequest(http.MethodPost, "/jobs", bytes.NewReader(body))
+	req.Header.Set("Authorization", "Bearer "+token)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	if rec.Code != http.StatusAccepted {
+		t.Fatalf("POST /jobs status = %d, want %d (%s)", rec.Code, http.StatusAccepted, rec.Body)
+	}
+	var created jobs.Job
+	if err := json.NewDecoder(rec.Body).Decode(&created); err != nil {
+		t.Fatalf("decode create response: %v", err)
+	}
+
+	var got jobs.Job
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		req = httptest.NewRequest(http.MethodGet, "/jobs/"+created.ID, nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		rec = httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("GET /jobs/%s status = %d, want %d", created.ID, rec.Code, http.StatusOK)
+		}
+		if err := json.NewDecoder(rec.Body).Decode(&got); err != nil {
+			t.Fatalf("decode get response: %v", err)
+		}
+		if got.Status == jobs.StatusSucceeded || got.Status == jobs.StatusFailed {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if got.Status != jobs.StatusSucceeded {
+		t.Fatalf("job status = %q, want %q", got.Status, jobs.StatusSucceeded)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/jobs", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	var list []jobs.Job
+	if err := json.NewDecoder(rec.Body).Decode(&list); err != nil {
+		t.Fatalf("decode list response: %v", err)
+	}
+	if len(list) != 1 {
+		t.Fatalf("GET /jobs returned %d jobs, want 1", len(list))
+	}
+}
+
+func TestJobRoutes_ForbidNonAdmin(t *testing.T) {
+	userRepo := repository.NewInMemoryUserRepository()
+	router, tokens, sessions := newTestRouter(t, userRepo)
+	token := bearer(t, tokens, sessions, "alice", domain.RoleUser)
+
+	body, _ := json.Marshal(createJobRequest{
+		Type:   jobs.TypeBulkExportUsers,
+		Params: json.RawMessage(`{}`),
+	})
+	req := httptest.NewRequest(http.MethodPost, "/jobs", bytes.NewReader(body))
+	req.Header.Set("Authorization", "Bearer "+token)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	if rec.Code != http.StatusForbidden {
+		t.Fatalf("POST /jobs as non-admin status = %d, want %d", rec.Code, http.StatusForbidden)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/jobs", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	if rec.Code != http.StatusForbidden {
+		t.Fatalf("GET /jobs as non-admin status = %d, want %d", rec.Code, http.StatusForbidden)
+	}
+}
@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+
+	"github.com/MarkoAnn/interfaceCapybara/pkg/auth"
+	"github.com/MarkoAnn/interfaceCapybara/pkg/domain"
+	"github.com/MarkoAnn/interfaceCapybara/pkg/events"
+	"github.com/MarkoAnn/interfaceCapybara/pkg/jobs"
+)
+
+// Deps bundles everything the router needs to wire up handlers. It exists so
+// NewRouter's signature doesn't grow a new parameter every time a subsystem
+// is added.
+type Deps struct {
+	Users     domain.UserRepository
+	Tokens    *auth.TokenManager
+	Sessions  auth.SessionStore
+	Jobs      jobs.JobRepository
+	JobPool   *jobs.Pool
+	Scheduler *jobs.Scheduler
+	Events    *events.Bus
+}
+
+// NewRouter builds the REST API surface for user CRUD, authentication, and
+// background jobs. Registration and login are public; every other user
+// route requires a valid bearer token, with List and Delete additionally
+// restricted to domain.RoleAdmin. Job routes are restricted to
+// domain.RoleAdmin too: jobs like bulk_export_users return every user in
+// the system, and bulk_import_users can set a new user's Role, so they
+// carry the same privileges as the admin-only user routes. /events carries
+// its own RoleAdmin check (EventsHandler does its own auth since the token
+// arrives as a query param, not a bearer header) for the same reason: every
+// event carries another account's name, age, and role.
+func NewRouter(deps Deps) chi.Router {
+	r := chi.NewRouter()
+	r.Use(middleware.Logger)
+
+	r.Get("/", EventsDemoPageHandler())
+	r.Get("/events", EventsHandler(deps.Events, deps.Tokens, deps.Sessions))
+
+	r.Post("/login", LoginHandler(deps.Users, deps.Tokens, deps.Sessions))
+
+	r.Route("/users", func(r chi.Router) {
+		r.Post("/", CreateUserHandler(deps.Users))
+
+		r.Group(func(r chi.Router) {
+			r.Use(auth.RequireAuth(deps.Tokens, deps.Sessions))
+
+			r.Get("/{id}", GetUserHandler(deps.Users))
+			r.Put("/{id}", UpdateUserHandler(deps.Users))
+
+			r.Group(func(r chi.Router) {
+				r.Use(auth.RequireAdmin)
+
+				r.Get("/", ListUsersHandler(deps.Users))
+				r.Delete("/{id}", DeleteUserHandler(deps.Users))
+			})
+		})
+	})
+
+	r.Route("/jobs", func(r chi.Router) {
+		r.Use(auth.RequireAuth(deps.Tokens, deps.Sessions))
+		r.Use(auth.RequireAdmin)
+
+		r.Post("/", CreateJobHandler(deps.Jobs, deps.JobPool, deps.Scheduler))
+		r.Get("/", ListJobsHandler(deps.Jobs))
+		r.Get("/{id}", GetJobHandler(deps.Jobs))
+	})
+
+	return r
+}
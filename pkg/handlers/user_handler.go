@@ -0,0 +1,210 @@
+// Package handlers wires domain.UserRepository up to an HTTP API.
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/MarkoAnn/interfaceCapybara/pkg/auth"
+	"github.com/MarkoAnn/interfaceCapybara/pkg/domain"
+)
+
+const defaultListLimit = 50
+
+// createUserRequest is decoded separately from domain.User because it
+// carries a plaintext password (hashed before storage) and never accepts a
+// client-supplied role, which would otherwise be a privilege escalation.
+type createUserRequest struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Age      int    `json:"age"`
+	Password string `json:"password"`
+}
+
+func CreateUserHandler(repo domain.UserRepository) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req createUserRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, http.StatusBadRequest, "invalid request body")
+			return
+		}
+		if req.ID == "" || req.Name == "" || req.Password == "" {
+			writeError(w, http.StatusBadRequest, "id, name and password are required")
+			return
+		}
+
+		passwordHash, err := auth.HashPassword(req.Password)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, "failed to hash password")
+			return
+		}
+
+		user := domain.User{
+			ID:           req.ID,
+			Name:         req.Name,
+			Age:          req.Age,
+			Role:         domain.RoleUser,
+			PasswordHash: passwordHash,
+		}
+		if err := repo.Create(user); err != nil {
+			writeRepoError(w, err)
+			return
+		}
+
+		writeJSON(w, http.StatusCreated, user)
+	}
+}
+
+// ListUsersHandler supports ?limit=&offset= pagination over the full user
+// list returned by the repository; sorted by ID so pages stay stable across
+// calls against backends that iterate in arbitrary order.
+func ListUsersHandler(repo domain.UserRepository) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		users, err := repo.List()
+		if err != nil {
+			writeRepoError(w, err)
+			return
+		}
+		sort.Slice(users, func(i, j int) bool { return users[i].ID < users[j].ID })
+
+		limit, offset, err := parsePagination(r)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		if offset > len(users) {
+			offset = len(users)
+		}
+		end := offset + limit
+		if end > len(users) {
+			end = len(users)
+		}
+
+		writeJSON(w, http.StatusOK, users[offset:end])
+	}
+}
+
+func parsePagination(r *http.Request) (limit, offset int, err error) {
+	limit = defaultListLimit
+	if v := r.URL.Query().Get("limit"); v != "" {
+		limit, err = strconv.Atoi(v)
+		if err != nil || limit < 0 {
+			return 0, 0, errors.New("invalid limit parameter")
+		}
+	}
+	if v := r.URL.Query().Get("offset"); v != "" {
+		offset, err = strconv.Atoi(v)
+		if err != nil || offset < 0 {
+			return 0, 0, errors.New("invalid offset parameter")
+		}
+	}
+	return limit, offset, nil
+}
+
+func GetUserHandler(repo domain.UserRepository) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id := chi.URLParam(r, "id")
+		if !allowedToAccess(r, id) {
+			writeError(w, http.StatusForbidden, "cannot access another user's record")
+			return
+		}
+
+		user, err := repo.Find(id)
+		if err != nil {
+			writeRepoError(w, err)
+			return
+		}
+
+		writeJSON(w, http.StatusOK, user)
+	}
+}
+
+// updateUserRequest only allows changing the fields a user should be able to
+// self-service; Role and PasswordHash are carried over from the existing
+// record instead of being reset to zero values on every update.
+type updateUserRequest struct {
+	Name string `json:"name"`
+	Age  int    `json:"age"`
+}
+
+func UpdateUserHandler(repo domain.UserRepository) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id := chi.URLParam(r, "id")
+		if !allowedToAccess(r, id) {
+			writeError(w, http.StatusForbidden, "cannot modify another user's record")
+			return
+		}
+
+		existing, err := repo.Find(id)
+		if err != nil {
+			writeRepoError(w, err)
+			return
+		}
+
+		var req updateUserRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, http.StatusBadRequest, "invalid request body")
+			return
+		}
+		existing.Name = req.Name
+		existing.Age = req.Age
+
+		if err := repo.Update(existing); err != nil {
+			writeRepoError(w, err)
+			return
+		}
+
+		writeJSON(w, http.StatusOK, existing)
+	}
+}
+
+// allowedToAccess reports whether the authenticated caller may read or
+// modify the record identified by id: admins may access any record, while
+// everyone else is restricted to their own.
+func allowedToAccess(r *http.Request, id string) bool {
+	identity, ok := auth.IdentityFromContext(r.Context())
+	if !ok {
+		return false
+	}
+	return identity.Role == domain.RoleAdmin || identity.UserID == id
+}
+
+func DeleteUserHandler(repo domain.UserRepository) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id := chi.URLParam(r, "id")
+
+		if err := repo.Delete(id); err != nil {
+			writeRepoError(w, err)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"error": message})
+}
+
+func writeRepoError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, domain.ErrUserNotFound):
+		writeError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, domain.ErrUserExists):
+		writeError(w, http.StatusConflict, err.Error())
+	default:
+		writeError(w, http.StatusInternalServerError, err.Error())
+	}
+}
@@ -0,0 +1,155 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/MarkoAnn/interfaceCapybara/pkg/auth"
+	"github.com/MarkoAnn/interfaceCapybara/pkg/domain"
+	"github.com/MarkoAnn/interfaceCapybara/pkg/events"
+	"github.com/MarkoAnn/interfaceCapybara/pkg/jobs"
+	"github.com/MarkoAnn/interfaceCapybara/pkg/repository"
+)
+
+func newTestRouter(t *testing.T, repo domain.UserRepository) (http.Handler, *auth.TokenManager, auth.SessionStore) {
+	t.Helper()
+	tokens := auth.NewTokenManager([]byte("test-secret"), time.Hour)
+	sessions := auth.NewInMemorySessionStore()
+	jobRepo := jobs.NewInMemoryJobRepository()
+	pool := jobs.NewPool(jobRepo, 1)
+	pool.RegisterHandler(jobs.TypeBulkImportUsers, jobs.BulkImportUsersHandler(repo))
+	pool.RegisterHandler(jobs.TypeBulkExportUsers, jobs.BulkExportUsersHandler(repo))
+	pool.RegisterHandler(jobs.TypeSendWelcomeEmail, jobs.SendWelcomeEmailHandler(repo))
+	scheduler := jobs.NewScheduler(pool)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	pool.Start(ctx)
+	scheduler.Start()
+	t.Cleanup(scheduler.Stop)
+
+	router := NewRouter(Deps{
+		Users:     repo,
+		Tokens:    tokens,
+		Sessions:  sessions,
+		Jobs:      jobRepo,
+		JobPool:   pool,
+		Scheduler: scheduler,
+		Events:    events.NewBus(),
+	})
+	return router, tokens, sessions
+}
+
+func bearer(t *testing.T, tokens *auth.TokenManager, sessions auth.SessionStore, userID string, role domain.Role) string {
+	t.Helper()
+	token, expiresAt, err := tokens.Issue(userID, role)
+	if err != nil {
+		t.Fatalf("Issue() error = %v", err)
+	}
+	if err := sessions.Create(auth.Session{Token: token, UserID: userID, Role: role, ExpiresAt: expiresAt}); err != nil {
+		t.Fatalf("sessions.Create() error = %v", err)
+	}
+	return token
+}
+
+func TestUserRoutes_CreateLoginGetUpdate(t *testing.T) {
+	repo := repository.NewInMemoryUserRepository()
+	router, tokens, sessions := newTestRouter(t, repo)
+
+	body, _ := json.Marshal(createUserRequest{ID: "alice", Name: "Alice", Age: 30, Password: "hunter2"})
+	req := httptest.NewRequest(http.MethodPost, "/users", bytes.NewReader(body))
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("POST /users status = %d, want %d (%s)", rec.Code, http.StatusCreated, rec.Body)
+	}
+
+	loginBody, _ := json.Marshal(loginRequest{ID: "alice", Password: "hunter2"})
+	req = httptest.NewRequest(http.MethodPost, "/login", bytes.NewReader(loginBody))
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("POST /login status = %d, want %d (%s)", rec.Code, http.StatusOK, rec.Body)
+	}
+	var loginResp loginResponse
+	if err := json.NewDecoder(rec.Body).Decode(&loginResp); err != nil {
+		t.Fatalf("decode login response: %v", err)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/users/alice", nil)
+	req.Header.Set("Authorization", "Bearer "+loginResp.Token)
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("GET /users/alice status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	var got domain.User
+	if err := json.NewDecoder(rec.Body).Decode(&got); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if got.Name != "Alice" || got.Age != 30 {
+		t.Fatalf("GET /users/alice = %+v, want name=Alice age=30", got)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/users/alice", nil)
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("GET /users/alice without token status = %d, want %d", rec.Code, http.StatusUnauthorized)
+	}
+
+	update, _ := json.Marshal(updateUserRequest{Name: "Alice B.", Age: 31})
+	req = httptest.NewRequest(http.MethodPut, "/users/alice", bytes.NewReader(update))
+	req.Header.Set("Authorization", "Bearer "+loginResp.Token)
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("PUT /users/alice status = %d, want %d", rec.Code, http.StatusOK)
+	}
+
+	bobToken := bearer(t, tokens, sessions, "bob", domain.RoleUser)
+	req = httptest.NewRequest(http.MethodGet, "/users/alice", nil)
+	req.Header.Set("Authorization", "Bearer "+bobToken)
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	if rec.Code != http.StatusForbidden {
+		t.Fatalf("GET /users/alice as bob status = %d, want %d", rec.Code, http.StatusForbidden)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/users", nil)
+	req.Header.Set("Authorization", "Bearer "+bobToken)
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	if rec.Code != http.StatusForbidden {
+		t.Fatalf("GET /users as bob status = %d, want %d", rec.Code, http.StatusForbidden)
+	}
+
+	adminToken := bearer(t, tokens, sessions, "root", domain.RoleAdmin)
+	req = httptest.NewRequest(http.MethodGet, "/users?limit=10&offset=0", nil)
+	req.Header.Set("Authorization", "Bearer "+adminToken)
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("GET /users as admin status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	var list []domain.User
+	if err := json.NewDecoder(rec.Body).Decode(&list); err != nil {
+		t.Fatalf("decode list response: %v", err)
+	}
+	if len(list) != 1 || list[0].Age != 31 {
+		t.Fatalf("GET /users = %+v, want one user aged 31", list)
+	}
+
+	req = httptest.NewRequest(http.MethodDelete, "/users/alice", nil)
+	req.Header.Set("Authorization", "Bearer "+adminToken)
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("DELETE /users/alice as admin status = %d, want %d", rec.Code, http.StatusNoContent)
+	}
+}
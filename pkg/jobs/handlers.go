@@ -0,0 +1,132 @@
+package jobs
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/MarkoAnn/interfaceCapybara/pkg/domain"
+)
+
+// Job types handled by the built-in Handlers below.
+const (
+	TypeBulkImportUsers  = "bulk_import_users"
+	TypeBulkExportUsers  = "bulk_export_users"
+	TypeSendWelcomeEmail = "send_welcome_email"
+)
+
+// bulkImportParams is the expected shape of a TypeBulkImportUsers job's
+// Params: Format selects the encoding of Data ("json", a JSON array of
+// domain.User, or "csv", with an "id,name,age" header row).
+type bulkImportParams struct {
+	Format string `json:"format"`
+	Data   string `json:"data"`
+}
+
+// BulkImportUsersHandler decodes job.Params as bulkImportParams and creates
+// every user it describes in repo, skipping (and reporting) any that
+// already exist rather than failing the whole batch.
+func BulkImportUsersHandler(repo domain.UserRepository) Handler {
+	return func(ctx context.Context, job Job) (interface{}, error) {
+		var params bulkImportParams
+		if err := json.Unmarshal(job.Params, &params); err != nil {
+			return nil, fmt.Errorf("bulk import: invalid params: %w", err)
+		}
+
+		users, err := decodeUsers(params)
+		if err != nil {
+			return nil, err
+		}
+
+		var imported, skipped []string
+		for _, user := range users {
+			// Role and PasswordHash are never attacker-controlled: a job's
+			// Params come from whoever submitted the job, not from the
+			// imported account itself, so trusting them here would let a
+			// bulk import mint an admin account or a pre-set password.
+			user.Role = domain.RoleUser
+			user.PasswordHash = ""
+			if err := repo.Create(user); err != nil {
+				skipped = append(skipped, user.ID)
+				continue
+			}
+			imported = append(imported, user.ID)
+		}
+
+		return map[string]any{"imported": imported, "skipped": skipped}, nil
+	}
+}
+
+func decodeUsers(params bulkImportParams) ([]domain.User, error) {
+	switch params.Format {
+	case "", "json":
+		var users []domain.User
+		if err := json.Unmarshal([]byte(params.Data), &users); err != nil {
+			return nil, fmt.Errorf("bulk import: invalid json data: %w", err)
+		}
+		return users, nil
+
+	case "csv":
+		reader := csv.NewReader(bytes.NewReader([]byte(params.Data)))
+		records, err := reader.ReadAll()
+		if err != nil {
+			return nil, fmt.Errorf("bulk import: invalid csv data: %w", err)
+		}
+		if len(records) == 0 {
+			return nil, nil
+		}
+		var users []domain.User
+		for _, record := range records[1:] { // skip header row
+			if len(record) < 3 {
+				return nil, fmt.Errorf("bulk import: expected id,name,age columns, got %v", record)
+			}
+			age, err := strconv.Atoi(record[2])
+			if err != nil {
+				return nil, fmt.Errorf("bulk import: invalid age %q: %w", record[2], err)
+			}
+			users = append(users, domain.User{ID: record[0], Name: record[1], Age: age})
+		}
+		return users, nil
+
+	default:
+		return nil, fmt.Errorf("bulk import: unsupported format %q", params.Format)
+	}
+}
+
+// BulkExportUsersHandler returns every user in repo as the job's result.
+func BulkExportUsersHandler(repo domain.UserRepository) Handler {
+	return func(ctx context.Context, job Job) (interface{}, error) {
+		users, err := repo.List()
+		if err != nil {
+			return nil, fmt.Errorf("bulk export: %w", err)
+		}
+		return map[string]any{"users": users}, nil
+	}
+}
+
+type welcomeEmailParams struct {
+	UserID string `json:"user_id"`
+}
+
+// SendWelcomeEmailHandler looks up the user named in job.Params and "sends"
+// them a welcome email. There is no real mail transport wired up yet, so it
+// just logs the send; swap the body for an actual mailer once one exists.
+func SendWelcomeEmailHandler(repo domain.UserRepository) Handler {
+	return func(ctx context.Context, job Job) (interface{}, error) {
+		var params welcomeEmailParams
+		if err := json.Unmarshal(job.Params, &params); err != nil {
+			return nil, fmt.Errorf("send welcome email: invalid params: %w", err)
+		}
+
+		user, err := repo.Find(params.UserID)
+		if err != nil {
+			return nil, fmt.Errorf("send welcome email: %w", err)
+		}
+
+		fmt.Printf("welcome email sent to %s <%s>\n", user.Name, user.ID)
+		return map[string]any{"sent_to": user.ID}, nil
+	}
+}
@@ -0,0 +1,34 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/MarkoAnn/interfaceCapybara/pkg/domain"
+	"github.com/MarkoAnn/interfaceCapybara/pkg/repository"
+)
+
+func TestBulkImportUsersHandler_IgnoresClientSuppliedRoleAndPassword(t *testing.T) {
+	repo := repository.NewInMemoryUserRepository()
+	handler := BulkImportUsersHandler(repo)
+
+	params, _ := json.Marshal(bulkImportParams{
+		Format: "json",
+		Data:   `[{"id":"mallory","name":"Mallory","age":30,"role":"admin"}]`,
+	})
+	if _, err := handler(context.Background(), Job{Params: params}); err != nil {
+		t.Fatalf("handler() error = %v", err)
+	}
+
+	got, err := repo.Find("mallory")
+	if err != nil {
+		t.Fatalf("Find() error = %v", err)
+	}
+	if got.Role != domain.RoleUser {
+		t.Fatalf("imported user Role = %q, want %q", got.Role, domain.RoleUser)
+	}
+	if got.PasswordHash != "" {
+		t.Fatalf("imported user PasswordHash = %q, want empty", got.PasswordHash)
+	}
+}
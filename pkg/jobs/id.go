@@ -0,0 +1,17 @@
+package jobs
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+)
+
+// NewID generates a random job identifier. Jobs submitted over HTTP don't
+// carry a client-supplied ID the way users do, since callers have no
+// natural key to pick for a one-off unit of work.
+func NewID() string {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		panic("jobs: failed to read random bytes: " + err.Error())
+	}
+	return hex.EncodeToString(buf)
+}
@@ -0,0 +1,49 @@
+// Package jobs implements a small background job subsystem: long-running
+// user operations (bulk import/export, welcome emails) are submitted as
+// Jobs, queued, and executed asynchronously by a worker pool so HTTP
+// handlers never block on them.
+package jobs
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+var ErrJobNotFound = errors.New("job not found")
+
+// Status is the lifecycle state of a Job.
+type Status string
+
+const (
+	StatusQueued    Status = "queued"
+	StatusRunning   Status = "running"
+	StatusSucceeded Status = "succeeded"
+	StatusFailed    Status = "failed"
+)
+
+// Job описывает единицу фоновой работы.
+type Job struct {
+	ID     string          `json:"id"`
+	Type   string          `json:"type"`
+	Status Status          `json:"status"`
+	Params json.RawMessage `json:"params,omitempty"`
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  string          `json:"error,omitempty"`
+
+	// CronStr, when set, marks this Job as the template for a recurring
+	// schedule (e.g. "0 2 * * *" for a nightly export) instead of a single
+	// one-off run. See Scheduler.
+	CronStr string `json:"cron_str,omitempty"`
+
+	CreationTime time.Time `json:"creation_time"`
+	UpdateTime   time.Time `json:"update_time"`
+}
+
+// JobRepository определяет методы для работы с фоновыми задачами.
+type JobRepository interface {
+	Create(job Job) error
+	List() ([]Job, error)
+	Find(id string) (Job, error)
+	Update(job Job) error
+}
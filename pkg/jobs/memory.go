@@ -0,0 +1,52 @@
+package jobs
+
+import "sync"
+
+// InMemoryJobRepository implements JobRepository with an in-memory map,
+// mirroring repository.InMemoryUserRepository. Jobs do not survive a
+// process restart.
+type InMemoryJobRepository struct {
+	jobs map[string]Job
+	mu   sync.Mutex
+}
+
+func NewInMemoryJobRepository() *InMemoryJobRepository {
+	return &InMemoryJobRepository{jobs: make(map[string]Job)}
+}
+
+func (repo *InMemoryJobRepository) Create(job Job) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	repo.jobs[job.ID] = job
+	return nil
+}
+
+func (repo *InMemoryJobRepository) List() ([]Job, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	var list []Job
+	for _, job := range repo.jobs {
+		list = append(list, job)
+	}
+	return list, nil
+}
+
+func (repo *InMemoryJobRepository) Find(id string) (Job, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	job, exists := repo.jobs[id]
+	if !exists {
+		return Job{}, ErrJobNotFound
+	}
+	return job, nil
+}
+
+func (repo *InMemoryJobRepository) Update(job Job) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if _, exists := repo.jobs[job.ID]; !exists {
+		return ErrJobNotFound
+	}
+	repo.jobs[job.ID] = job
+	return nil
+}
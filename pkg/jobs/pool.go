@@ -0,0 +1,113 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Handler executes one Job and returns its JSON-encodable result.
+type Handler func(ctx context.Context, job Job) (result interface{}, err error)
+
+// Pool is a fixed-size worker pool that pulls queued Jobs off an internal
+// channel and runs them through the Handler registered for their Type.
+// Handlers are pluggable: register one per job type before calling Start.
+type Pool struct {
+	repo     JobRepository
+	workers  int
+	handlers map[string]Handler
+	queue    chan string
+}
+
+// NewPool builds a Pool with the given number of concurrent workers,
+// backed by repo for job state.
+func NewPool(repo JobRepository, workers int) *Pool {
+	if workers < 1 {
+		workers = 1
+	}
+	return &Pool{
+		repo:     repo,
+		workers:  workers,
+		handlers: make(map[string]Handler),
+		queue:    make(chan string, 256),
+	}
+}
+
+// RegisterHandler associates jobType with the Handler run for jobs of that
+// type. It must be called before Start.
+func (p *Pool) RegisterHandler(jobType string, handler Handler) {
+	p.handlers[jobType] = handler
+}
+
+// Start launches the worker goroutines. They run until ctx is canceled.
+func (p *Pool) Start(ctx context.Context) {
+	for i := 0; i < p.workers; i++ {
+		go p.worker(ctx)
+	}
+}
+
+func (p *Pool) worker(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case id := <-p.queue:
+			p.process(ctx, id)
+		}
+	}
+}
+
+// Enqueue persists job (status forced to StatusQueued) and schedules it for
+// execution by the next free worker.
+func (p *Pool) Enqueue(job Job) error {
+	now := time.Now()
+	job.Status = StatusQueued
+	if job.CreationTime.IsZero() {
+		job.CreationTime = now
+	}
+	job.UpdateTime = now
+	if err := p.repo.Create(job); err != nil {
+		return fmt.Errorf("jobs: enqueue: %w", err)
+	}
+	p.queue <- job.ID
+	return nil
+}
+
+func (p *Pool) process(ctx context.Context, id string) {
+	job, err := p.repo.Find(id)
+	if err != nil {
+		return
+	}
+
+	job.Status = StatusRunning
+	job.UpdateTime = time.Now()
+	if err := p.repo.Update(job); err != nil {
+		return
+	}
+
+	handler, ok := p.handlers[job.Type]
+	if !ok {
+		p.finish(job, nil, fmt.Errorf("jobs: no handler registered for type %q", job.Type))
+		return
+	}
+
+	result, err := handler(ctx, job)
+	p.finish(job, result, err)
+}
+
+func (p *Pool) finish(job Job, result interface{}, runErr error) {
+	job.UpdateTime = time.Now()
+	if runErr != nil {
+		job.Status = StatusFailed
+		job.Error = runErr.Error()
+	} else {
+		job.Status = StatusSucceeded
+		if result != nil {
+			if encoded, err := json.Marshal(result); err == nil {
+				job.Result = encoded
+			}
+		}
+	}
+	p.repo.Update(job)
+}
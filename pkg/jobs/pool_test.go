@@ -0,0 +1,66 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestPool_RunsRegisteredHandler(t *testing.T) {
+	repo := NewInMemoryJobRepository()
+	pool := NewPool(repo, 2)
+	pool.RegisterHandler("echo", func(ctx context.Context, job Job) (interface{}, error) {
+		return map[string]string{"echoed": string(job.Params)}, nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	pool.Start(ctx)
+
+	job := Job{ID: NewID(), Type: "echo", Params: json.RawMessage(`"hello"`)}
+	if err := pool.Enqueue(job); err != nil {
+		t.Fatalf("Enqueue() error = %v", err)
+	}
+
+	got := waitForTerminalStatus(t, repo, job.ID)
+	if got.Status != StatusSucceeded {
+		t.Fatalf("job status = %q, want %q (error: %s)", got.Status, StatusSucceeded, got.Error)
+	}
+}
+
+func TestPool_UnknownTypeFails(t *testing.T) {
+	repo := NewInMemoryJobRepository()
+	pool := NewPool(repo, 1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	pool.Start(ctx)
+
+	job := Job{ID: NewID(), Type: "does-not-exist"}
+	if err := pool.Enqueue(job); err != nil {
+		t.Fatalf("Enqueue() error = %v", err)
+	}
+
+	got := waitForTerminalStatus(t, repo, job.ID)
+	if got.Status != StatusFailed {
+		t.Fatalf("job status = %q, want %q", got.Status, StatusFailed)
+	}
+}
+
+func waitForTerminalStatus(t *testing.T, repo JobRepository, id string) Job {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		job, err := repo.Find(id)
+		if err != nil {
+			t.Fatalf("Find() error = %v", err)
+		}
+		if job.Status == StatusSucceeded || job.Status == StatusFailed {
+			return job
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatalf("job %s did not reach a terminal status in time", id)
+	return Job{}
+}
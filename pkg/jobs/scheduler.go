@@ -0,0 +1,46 @@
+package jobs
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/robfig/cron/v3"
+)
+
+// Scheduler enqueues a fresh run of a recurring Job (one with CronStr set)
+// on every tick of its cron schedule, via Pool.
+type Scheduler struct {
+	cron *cron.Cron
+	pool *Pool
+}
+
+func NewScheduler(pool *Pool) *Scheduler {
+	return &Scheduler{cron: cron.New(), pool: pool}
+}
+
+// Schedule registers template for recurring execution according to
+// template.CronStr. Each tick enqueues a new Job with a fresh ID and
+// CreationTime, reusing template's Type and Params.
+func (s *Scheduler) Schedule(template Job) (cron.EntryID, error) {
+	if template.CronStr == "" {
+		return 0, errors.New("jobs: cron_str is required to schedule a recurring job")
+	}
+
+	entryID, err := s.cron.AddFunc(template.CronStr, func() {
+		run := template
+		run.ID = NewID()
+		s.pool.Enqueue(run)
+	})
+	if err != nil {
+		return 0, fmt.Errorf("jobs: schedule: %w", err)
+	}
+	return entryID, nil
+}
+
+// Remove cancels a previously scheduled recurring job.
+func (s *Scheduler) Remove(entryID cron.EntryID) {
+	s.cron.Remove(entryID)
+}
+
+func (s *Scheduler) Start() { s.cron.Start() }
+func (s *Scheduler) Stop()  { s.cron.Stop() }
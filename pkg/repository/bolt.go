@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/MarkoAnn/interfaceCapybara/pkg/domain"
+	bolt "go.etcd.io/bbolt"
+)
+
+var usersBucket = []byte("users")
+
+// BoltUserRepository implements domain.UserRepository on top of a local
+// BoltDB file, storing each user as JSON keyed by ID.
+type BoltUserRepository struct {
+	db *bolt.DB
+}
+
+// NewBoltUserRepository opens (or creates) the bucket used to store users in
+// an already-opened BoltDB handle.
+func NewBoltUserRepository(db *bolt.DB) (*BoltUserRepository, error) {
+	err := db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(usersBucket)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("bolt repository: create bucket: %w", err)
+	}
+	return &BoltUserRepository{db: db}, nil
+}
+
+func (repo *BoltUserRepository) Create(user domain.User) error {
+	return repo.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(usersBucket)
+		if bucket.Get([]byte(user.ID)) != nil {
+			return domain.ErrUserExists
+		}
+		return putUser(bucket, user)
+	})
+}
+
+func (repo *BoltUserRepository) List() ([]domain.User, error) {
+	var users []domain.User
+	err := repo.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(usersBucket).ForEach(func(_, value []byte) error {
+			var rec userRecord
+			if err := json.Unmarshal(value, &rec); err != nil {
+				return err
+			}
+			users = append(users, rec.user())
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("bolt repository: list: %w", err)
+	}
+	return users, nil
+}
+
+func (repo *BoltUserRepository) Find(id string) (domain.User, error) {
+	var rec userRecord
+	err := repo.db.View(func(tx *bolt.Tx) error {
+		value := tx.Bucket(usersBucket).Get([]byte(id))
+		if value == nil {
+			return domain.ErrUserNotFound
+		}
+		return json.Unmarshal(value, &rec)
+	})
+	if err != nil {
+		return domain.User{}, err
+	}
+	return rec.user(), nil
+}
+
+func (repo *BoltUserRepository) Update(user domain.User) error {
+	return repo.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(usersBucket)
+		if bucket.Get([]byte(user.ID)) == nil {
+			return domain.ErrUserNotFound
+		}
+		return putUser(bucket, user)
+	})
+}
+
+func (repo *BoltUserRepository) Delete(id string) error {
+	return repo.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(usersBucket)
+		if bucket.Get([]byte(id)) == nil {
+			return domain.ErrUserNotFound
+		}
+		return bucket.Delete([]byte(id))
+	})
+}
+
+func putUser(bucket *bolt.Bucket, user domain.User) error {
+	data, err := json.Marshal(newUserRecord(user))
+	if err != nil {
+		return err
+	}
+	return bucket.Put([]byte(user.ID), data)
+}
@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"path/filepath"
+	"testing"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+func TestBoltUserRepository_Conformance(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "users.db")
+	db, err := bolt.Open(dbPath, 0600, nil)
+	if err != nil {
+		t.Fatalf("bolt.Open() error = %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	repo, err := NewBoltUserRepository(db)
+	if err != nil {
+		t.Fatalf("NewBoltUserRepository() error = %v", err)
+	}
+	runConformanceTests(t, repo)
+}
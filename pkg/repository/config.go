@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/MarkoAnn/interfaceCapybara/pkg/domain"
+	"github.com/redis/go-redis/v9"
+	bolt "go.etcd.io/bbolt"
+)
+
+// Backend selects which domain.UserRepository implementation NewRepository
+// builds.
+type Backend string
+
+const (
+	BackendMemory Backend = "memory"
+	BackendSQL    Backend = "sql"
+	BackendBolt   Backend = "bolt"
+	BackendRedis  Backend = "redis"
+)
+
+// Config holds the settings needed to construct any of the supported
+// backends. Only the fields relevant to Backend need to be set; the rest are
+// ignored. Values are typically sourced from environment variables by
+// cmd/server.
+type Config struct {
+	Backend Backend
+
+	// SQL
+	SQLDriver string // e.g. "postgres" or "sqlite3"
+	SQLDSN    string
+
+	// Bolt
+	BoltPath string
+
+	// Redis
+	RedisAddr     string
+	RedisPassword string
+	RedisDB       int
+}
+
+// NewRepository builds the domain.UserRepository selected by cfg.Backend. It
+// is the single place that knows how to wire up each storage backend from a
+// Config; cmd/server selects its backend through it.
+func NewRepository(cfg Config) (domain.UserRepository, error) {
+	switch cfg.Backend {
+	case BackendMemory, "":
+		return NewInMemoryUserRepository(), nil
+
+	case BackendSQL:
+		db, err := sql.Open(cfg.SQLDriver, cfg.SQLDSN)
+		if err != nil {
+			return nil, fmt.Errorf("repository: open sql: %w", err)
+		}
+		return NewSQLUserRepository(db)
+
+	case BackendBolt:
+		db, err := bolt.Open(cfg.BoltPath, 0600, nil)
+		if err != nil {
+			return nil, fmt.Errorf("repository: open bolt: %w", err)
+		}
+		return NewBoltUserRepository(db)
+
+	case BackendRedis:
+		client := redis.NewClient(&redis.Options{
+			Addr:     cfg.RedisAddr,
+			Password: cfg.RedisPassword,
+			DB:       cfg.RedisDB,
+		})
+		if err := client.Ping(context.Background()).Err(); err != nil {
+			return nil, fmt.Errorf("repository: connect redis: %w", err)
+		}
+		return NewRedisUserRepository(client, context.Background()), nil
+
+	default:
+		return nil, fmt.Errorf("repository: unknown backend %q", cfg.Backend)
+	}
+}
@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/MarkoAnn/interfaceCapybara/pkg/domain"
+)
+
+// runConformanceTests exercises the domain.UserRepository contract against
+// repo. Every backend constructor gets its own *_test.go that calls this
+// with a fresh, isolated repository so the suite only needs to be written
+// once.
+func runConformanceTests(t *testing.T, repo domain.UserRepository) {
+	t.Helper()
+
+	alice := domain.User{ID: "alice", Name: "Alice", Age: 30, Role: domain.RoleAdmin, PasswordHash: "hashed-password"}
+
+	t.Run("create and find", func(t *testing.T) {
+		if err := repo.Create(alice); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+		got, err := repo.Find(alice.ID)
+		if err != nil {
+			t.Fatalf("Find() error = %v", err)
+		}
+		if got != alice {
+			t.Fatalf("Find() = %+v, want %+v", got, alice)
+		}
+	})
+
+	t.Run("role and password hash round-trip", func(t *testing.T) {
+		got, err := repo.Find(alice.ID)
+		if err != nil {
+			t.Fatalf("Find() error = %v", err)
+		}
+		if got.Role != domain.RoleAdmin {
+			t.Fatalf("Find().Role = %q, want %q", got.Role, domain.RoleAdmin)
+		}
+		if got.PasswordHash != alice.PasswordHash {
+			t.Fatalf("Find().PasswordHash = %q, want %q", got.PasswordHash, alice.PasswordHash)
+		}
+	})
+
+	t.Run("create duplicate fails", func(t *testing.T) {
+		err := repo.Create(alice)
+		if !errors.Is(err, domain.ErrUserExists) {
+			t.Fatalf("Create() error = %v, want ErrUserExists", err)
+		}
+	})
+
+	t.Run("find missing fails", func(t *testing.T) {
+		_, err := repo.Find("missing")
+		if !errors.Is(err, domain.ErrUserNotFound) {
+			t.Fatalf("Find() error = %v, want ErrUserNotFound", err)
+		}
+	})
+
+	t.Run("list includes created user", func(t *testing.T) {
+		users, err := repo.List()
+		if err != nil {
+			t.Fatalf("List() error = %v", err)
+		}
+		if !containsUser(users, alice) {
+			t.Fatalf("List() = %+v, want to contain %+v", users, alice)
+		}
+	})
+
+	t.Run("update existing", func(t *testing.T) {
+		updated := alice
+		updated.Age = 31
+		if err := repo.Update(updated); err != nil {
+			t.Fatalf("Update() error = %v", err)
+		}
+		got, err := repo.Find(alice.ID)
+		if err != nil {
+			t.Fatalf("Find() error = %v", err)
+		}
+		if got.Age != 31 {
+			t.Fatalf("Find().Age = %d, want 31", got.Age)
+		}
+	})
+
+	t.Run("update missing fails", func(t *testing.T) {
+		err := repo.Update(domain.User{ID: "missing", Name: "Nobody", Age: 0})
+		if !errors.Is(err, domain.ErrUserNotFound) {
+			t.Fatalf("Update() error = %v, want ErrUserNotFound", err)
+		}
+	})
+
+	t.Run("delete existing", func(t *testing.T) {
+		if err := repo.Delete(alice.ID); err != nil {
+			t.Fatalf("Delete() error = %v", err)
+		}
+		_, err := repo.Find(alice.ID)
+		if !errors.Is(err, domain.ErrUserNotFound) {
+			t.Fatalf("Find() after delete error = %v, want ErrUserNotFound", err)
+		}
+	})
+
+	t.Run("delete missing fails", func(t *testing.T) {
+		err := repo.Delete("missing")
+		if !errors.Is(err, domain.ErrUserNotFound) {
+			t.Fatalf("Delete() error = %v, want ErrUserNotFound", err)
+		}
+	})
+}
+
+func containsUser(users []domain.User, want domain.User) bool {
+	for _, u := range users {
+		if u == want {
+			return true
+		}
+	}
+	return false
+}
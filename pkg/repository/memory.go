@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"sync"
+
+	"github.com/MarkoAnn/interfaceCapybara/pkg/domain"
+)
+
+// InMemoryUserRepository реализует интерфейс domain.UserRepository с
+// использованием in-memory хранилища. It is primarily useful for tests and
+// local development; it does not survive a process restart.
+type InMemoryUserRepository struct {
+	users map[string]domain.User
+	mu    sync.Mutex
+}
+
+func NewInMemoryUserRepository() *InMemoryUserRepository {
+	return &InMemoryUserRepository{users: make(map[string]domain.User)}
+}
+
+func (repo *InMemoryUserRepository) Create(user domain.User) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if _, exists := repo.users[user.ID]; exists {
+		return domain.ErrUserExists
+	}
+	repo.users[user.ID] = user
+	return nil
+}
+
+func (repo *InMemoryUserRepository) List() ([]domain.User, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	var userList []domain.User
+	for _, user := range repo.users {
+		userList = append(userList, user)
+	}
+	return userList, nil
+}
+
+func (repo *InMemoryUserRepository) Find(id string) (domain.User, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	user, exists := repo.users[id]
+	if !exists {
+		return domain.User{}, domain.ErrUserNotFound
+	}
+	return user, nil
+}
+
+func (repo *InMemoryUserRepository) Update(user domain.User) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if _, exists := repo.users[user.ID]; !exists {
+		return domain.ErrUserNotFound
+	}
+	repo.users[user.ID] = user
+	return nil
+}
+
+func (repo *InMemoryUserRepository) Delete(id string) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if _, exists := repo.users[id]; !exists {
+		return domain.ErrUserNotFound
+	}
+	delete(repo.users, id)
+	return nil
+}
@@ -0,0 +1,7 @@
+package repository
+
+import "testing"
+
+func TestInMemoryUserRepository_Conformance(t *testing.T) {
+	runConformanceTests(t, NewInMemoryUserRepository())
+}
@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"github.com/MarkoAnn/interfaceCapybara/pkg/domain"
+	"github.com/MarkoAnn/interfaceCapybara/pkg/events"
+)
+
+// PublishingUserRepository decorates a domain.UserRepository, publishing a
+// domain event on bus after every successful Create/Update/Delete. Reads
+// pass straight through to the wrapped repository.
+type PublishingUserRepository struct {
+	domain.UserRepository
+	bus *events.Bus
+}
+
+// NewPublishingUserRepository wraps repo so its writes are published on bus.
+func NewPublishingUserRepository(repo domain.UserRepository, bus *events.Bus) *PublishingUserRepository {
+	return &PublishingUserRepository{UserRepository: repo, bus: bus}
+}
+
+func (r *PublishingUserRepository) Create(user domain.User) error {
+	if err := r.UserRepository.Create(user); err != nil {
+		return err
+	}
+	r.bus.Publish(events.Event{Topic: events.TopicUserCreated, Payload: user})
+	return nil
+}
+
+func (r *PublishingUserRepository) Update(user domain.User) error {
+	if err := r.UserRepository.Update(user); err != nil {
+		return err
+	}
+	r.bus.Publish(events.Event{Topic: events.TopicUserUpdated, Payload: user})
+	return nil
+}
+
+func (r *PublishingUserRepository) Delete(id string) error {
+	if err := r.UserRepository.Delete(id); err != nil {
+		return err
+	}
+	r.bus.Publish(events.Event{Topic: events.TopicUserDeleted, Payload: domain.User{ID: id}})
+	return nil
+}
@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/MarkoAnn/interfaceCapybara/pkg/domain"
+	"github.com/MarkoAnn/interfaceCapybara/pkg/events"
+)
+
+func TestPublishingUserRepository_PublishesOnWrite(t *testing.T) {
+	bus := events.NewBus()
+	sub := bus.Subscribe()
+	defer bus.Unsubscribe(sub)
+
+	repo := NewPublishingUserRepository(NewInMemoryUserRepository(), bus)
+	user := domain.User{ID: "alice", Name: "Alice", Age: 30}
+
+	if err := repo.Create(user); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	assertTopic(t, sub, events.TopicUserCreated)
+
+	user.Age = 31
+	if err := repo.Update(user); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	assertTopic(t, sub, events.TopicUserUpdated)
+
+	if err := repo.Delete(user.ID); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	assertTopic(t, sub, events.TopicUserDeleted)
+}
+
+func TestPublishingUserRepository_NoPublishOnFailedWrite(t *testing.T) {
+	bus := events.NewBus()
+	sub := bus.Subscribe()
+	defer bus.Unsubscribe(sub)
+
+	repo := NewPublishingUserRepository(NewInMemoryUserRepository(), bus)
+
+	if err := repo.Update(domain.User{ID: "missing"}); err == nil {
+		t.Fatal("Update() error = nil, want domain.ErrUserNotFound")
+	}
+
+	select {
+	case event := <-sub.Events():
+		t.Fatalf("Events() delivered unexpected event %+v after a failed write", event)
+	default:
+	}
+}
+
+func assertTopic(t *testing.T, sub *events.Subscription, want string) {
+	t.Helper()
+	select {
+	case event := <-sub.Events():
+		if event.Topic != want {
+			t.Fatalf("Events() topic = %q, want %q", event.Topic, want)
+		}
+	default:
+		t.Fatalf("Events() had no pending event, want topic %q", want)
+	}
+}
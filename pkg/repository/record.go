@@ -0,0 +1,37 @@
+package repository
+
+import "github.com/MarkoAnn/interfaceCapybara/pkg/domain"
+
+// userRecord is the on-disk shape used by the JSON-backed repositories
+// (Bolt, Redis). domain.User's json tags are tuned for API responses
+// (PasswordHash is excluded via `json:"-"` so it never leaks in a handler
+// response), so marshaling a domain.User directly for storage would
+// silently drop the password hash on every read. Repositories that persist
+// users as JSON should marshal/unmarshal through userRecord instead.
+type userRecord struct {
+	ID           string      `json:"id"`
+	Name         string      `json:"name"`
+	Age          int         `json:"age"`
+	Role         domain.Role `json:"role"`
+	PasswordHash string      `json:"password_hash"`
+}
+
+func newUserRecord(user domain.User) userRecord {
+	return userRecord{
+		ID:           user.ID,
+		Name:         user.Name,
+		Age:          user.Age,
+		Role:         user.Role,
+		PasswordHash: user.PasswordHash,
+	}
+}
+
+func (r userRecord) user() domain.User {
+	return domain.User{
+		ID:           r.ID,
+		Name:         r.Name,
+		Age:          r.Age,
+		Role:         r.Role,
+		PasswordHash: r.PasswordHash,
+	}
+}
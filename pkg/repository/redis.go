@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/MarkoAnn/interfaceCapybara/pkg/domain"
+	"github.com/redis/go-redis/v9"
+)
+
+const redisKeyPrefix = "user:"
+
+// RedisUserRepository implements domain.UserRepository on top of Redis,
+// storing each user as a JSON string under "user:<id>".
+type RedisUserRepository struct {
+	client *redis.Client
+	ctx    context.Context
+}
+
+// NewRedisUserRepository wraps an already-connected Redis client. ctx bounds
+// every call issued by the repository; pass context.Background() for a
+// long-lived server process.
+func NewRedisUserRepository(client *redis.Client, ctx context.Context) *RedisUserRepository {
+	return &RedisUserRepository{client: client, ctx: ctx}
+}
+
+func (repo *RedisUserRepository) Create(user domain.User) error {
+	key := redisKeyPrefix + user.ID
+	exists, err := repo.client.Exists(repo.ctx, key).Result()
+	if err != nil {
+		return fmt.Errorf("redis repository: create: %w", err)
+	}
+	if exists > 0 {
+		return domain.ErrUserExists
+	}
+	return repo.put(user)
+}
+
+func (repo *RedisUserRepository) List() ([]domain.User, error) {
+	var users []domain.User
+	iter := repo.client.Scan(repo.ctx, 0, redisKeyPrefix+"*", 0).Iterator()
+	for iter.Next(repo.ctx) {
+		value, err := repo.client.Get(repo.ctx, iter.Val()).Result()
+		if err != nil {
+			return nil, fmt.Errorf("redis repository: list: %w", err)
+		}
+		var rec userRecord
+		if err := json.Unmarshal([]byte(value), &rec); err != nil {
+			return nil, fmt.Errorf("redis repository: list: unmarshal: %w", err)
+		}
+		users = append(users, rec.user())
+	}
+	if err := iter.Err(); err != nil {
+		return nil, fmt.Errorf("redis repository: list: scan: %w", err)
+	}
+	return users, nil
+}
+
+func (repo *RedisUserRepository) Find(id string) (domain.User, error) {
+	value, err := repo.client.Get(repo.ctx, redisKeyPrefix+id).Result()
+	if errors.Is(err, redis.Nil) {
+		return domain.User{}, domain.ErrUserNotFound
+	}
+	if err != nil {
+		return domain.User{}, fmt.Errorf("redis repository: find: %w", err)
+	}
+	var rec userRecord
+	if err := json.Unmarshal([]byte(value), &rec); err != nil {
+		return domain.User{}, fmt.Errorf("redis repository: find: unmarshal: %w", err)
+	}
+	return rec.user(), nil
+}
+
+func (repo *RedisUserRepository) Update(user domain.User) error {
+	key := redisKeyPrefix + user.ID
+	exists, err := repo.client.Exists(repo.ctx, key).Result()
+	if err != nil {
+		return fmt.Errorf("redis repository: update: %w", err)
+	}
+	if exists == 0 {
+		return domain.ErrUserNotFound
+	}
+	return repo.put(user)
+}
+
+func (repo *RedisUserRepository) Delete(id string) error {
+	deleted, err := repo.client.Del(repo.ctx, redisKeyPrefix+id).Result()
+	if err != nil {
+		return fmt.Errorf("redis repository: delete: %w", err)
+	}
+	if deleted == 0 {
+		return domain.ErrUserNotFound
+	}
+	return nil
+}
+
+func (repo *RedisUserRepository) put(user domain.User) error {
+	data, err := json.Marshal(newUserRecord(user))
+	if err != nil {
+		return fmt.Errorf("redis repository: marshal: %w", err)
+	}
+	if err := repo.client.Set(repo.ctx, redisKeyPrefix+user.ID, data, 0).Err(); err != nil {
+		return fmt.Errorf("redis repository: set: %w", err)
+	}
+	return nil
+}
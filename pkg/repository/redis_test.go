@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+func envOrDefault(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
+
+// TestRedisUserRepository_Conformance requires a reachable Redis instance
+// (REDIS_ADDR, defaulting to localhost:6379) and is skipped otherwise, since
+// this module has no way to spin one up itself.
+func TestRedisUserRepository_Conformance(t *testing.T) {
+	addr := envOrDefault("REDIS_ADDR", "localhost:6379")
+	client := redis.NewClient(&redis.Options{Addr: addr})
+	t.Cleanup(func() { client.Close() })
+
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+	if err := client.Ping(ctx).Err(); err != nil {
+		t.Skipf("redis not reachable at %s: %v", addr, err)
+	}
+
+	repo := NewRedisUserRepository(client, context.Background())
+	t.Cleanup(func() {
+		keys, _ := client.Keys(context.Background(), redisKeyPrefix+"*").Result()
+		if len(keys) > 0 {
+			client.Del(context.Background(), keys...)
+		}
+	})
+	runConformanceTests(t, repo)
+}
@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/MarkoAnn/interfaceCapybara/pkg/domain"
+)
+
+// SQLUserRepository implements domain.UserRepository on top of database/sql,
+// so it works against drivers that accept $N placeholders (Postgres,
+// SQLite); MySQL's ? placeholders are not supported. The caller is responsible for opening *sql.DB with the driver
+// of choice and registering it via the blank import (e.g. lib/pq or
+// mattn/go-sqlite3) before constructing this repository.
+type SQLUserRepository struct {
+	db *sql.DB
+}
+
+// NewSQLUserRepository wraps an already-opened *sql.DB and ensures the users
+// table exists.
+func NewSQLUserRepository(db *sql.DB) (*SQLUserRepository, error) {
+	repo := &SQLUserRepository{db: db}
+	if err := repo.migrate(); err != nil {
+		return nil, fmt.Errorf("sql repository: migrate: %w", err)
+	}
+	return repo, nil
+}
+
+func (repo *SQLUserRepository) migrate() error {
+	_, err := repo.db.Exec(`
+		CREATE TABLE IF NOT EXISTS users (
+			id            TEXT PRIMARY KEY,
+			name          TEXT NOT NULL,
+			age           INTEGER NOT NULL,
+			role          TEXT NOT NULL,
+			password_hash TEXT NOT NULL
+		)
+	`)
+	return err
+}
+
+func (repo *SQLUserRepository) Create(user domain.User) error {
+	_, err := repo.db.Exec(`INSERT INTO users (id, name, age, role, password_hash) VALUES ($1, $2, $3, $4, $5)`,
+		user.ID, user.Name, user.Age, user.Role, user.PasswordHash)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return domain.ErrUserExists
+		}
+		return fmt.Errorf("sql repository: create: %w", err)
+	}
+	return nil
+}
+
+func (repo *SQLUserRepository) List() ([]domain.User, error) {
+	rows, err := repo.db.Query(`SELECT id, name, age, role, password_hash FROM users`)
+	if err != nil {
+		return nil, fmt.Errorf("sql repository: list: %w", err)
+	}
+	defer rows.Close()
+
+	var users []domain.User
+	for rows.Next() {
+		var u domain.User
+		if err := rows.Scan(&u.ID, &u.Name, &u.Age, &u.Role, &u.PasswordHash); err != nil {
+			return nil, fmt.Errorf("sql repository: list: scan: %w", err)
+		}
+		users = append(users, u)
+	}
+	return users, rows.Err()
+}
+
+func (repo *SQLUserRepository) Find(id string) (domain.User, error) {
+	var u domain.User
+	err := repo.db.QueryRow(`SELECT id, name, age, role, password_hash FROM users WHERE id = $1`, id).
+		Scan(&u.ID, &u.Name, &u.Age, &u.Role, &u.PasswordHash)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.User{}, domain.ErrUserNotFound
+	}
+	if err != nil {
+		return domain.User{}, fmt.Errorf("sql repository: find: %w", err)
+	}
+	return u, nil
+}
+
+func (repo *SQLUserRepository) Update(user domain.User) error {
+	result, err := repo.db.Exec(`UPDATE users SET name = $1, age = $2, role = $3, password_hash = $4 WHERE id = $5`,
+		user.Name, user.Age, user.Role, user.PasswordHash, user.ID)
+	if err != nil {
+		return fmt.Errorf("sql repository: update: %w", err)
+	}
+	return requireAffectedRow(result)
+}
+
+func (repo *SQLUserRepository) Delete(id string) error {
+	result, err := repo.db.Exec(`DELETE FROM users WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("sql repository: delete: %w", err)
+	}
+	return requireAffectedRow(result)
+}
+
+func requireAffectedRow(result sql.Result) error {
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("sql repository: rows affected: %w", err)
+	}
+	if rows == 0 {
+		return domain.ErrUserNotFound
+	}
+	return nil
+}
+
+// isUniqueViolation is a best-effort check since database/sql does not
+// expose a driver-agnostic error type for constraint violations.
+func isUniqueViolation(err error) bool {
+	msg := err.Error()
+	return strings.Contains(msg, "UNIQUE constraint") || strings.Contains(msg, "duplicate key")
+}
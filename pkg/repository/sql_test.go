@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"database/sql"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestSQLUserRepository_Conformance(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatalf("sql.Open() error = %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	repo, err := NewSQLUserRepository(db)
+	if err != nil {
+		t.Fatalf("NewSQLUserRepository() error = %v", err)
+	}
+	runConformanceTests(t, repo)
+}
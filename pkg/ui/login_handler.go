@@ -0,0 +1,67 @@
+package ui
+
+import (
+	"errors"
+	"html/template"
+	"net/http"
+
+	"github.com/gorilla/csrf"
+
+	"github.com/MarkoAnn/interfaceCapybara/pkg/auth"
+	"github.com/MarkoAnn/interfaceCapybara/pkg/domain"
+)
+
+type loginPageData struct {
+	Error     string
+	CSRFField template.HTML
+}
+
+// LoginPageHandler renders the login form and, on POST, verifies the
+// submitted credentials the same way handlers.LoginHandler does before
+// setting the session cookie that requireSession checks.
+func LoginPageHandler(repo domain.UserRepository, tokens *auth.TokenManager, sessions auth.SessionStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			render(w, "login.html", loginPageData{CSRFField: csrf.TemplateField(r)})
+			return
+		}
+
+		user, err := repo.Find(r.FormValue("id"))
+		if err != nil {
+			if !errors.Is(err, domain.ErrUserNotFound) {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			render(w, "login.html", loginPageData{Error: "invalid credentials", CSRFField: csrf.TemplateField(r)})
+			return
+		}
+		if auth.ComparePassword(user.PasswordHash, r.FormValue("password")) != nil {
+			render(w, "login.html", loginPageData{Error: "invalid credentials", CSRFField: csrf.TemplateField(r)})
+			return
+		}
+
+		token, expiresAt, err := tokens.Issue(user.ID, user.Role)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if err := sessions.Create(auth.Session{Token: token, UserID: user.ID, Role: user.Role, ExpiresAt: expiresAt}); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		setSessionCookie(w, token, expiresAt)
+		http.Redirect(w, r, "/ui/users", http.StatusSeeOther)
+	}
+}
+
+// LogoutHandler revokes the caller's session and clears their cookie.
+func LogoutHandler(sessions auth.SessionStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if cookie, err := r.Cookie(sessionCookieName); err == nil {
+			sessions.Revoke(cookie.Value)
+		}
+		clearSessionCookie(w)
+		http.Redirect(w, r, "/ui/login", http.StatusSeeOther)
+	}
+}
@@ -0,0 +1,24 @@
+// Package ui serves a server-rendered HTML admin frontend for browsing and
+// editing users, mirroring the JSON API under pkg/handlers.
+package ui
+
+import (
+	"embed"
+	"html/template"
+	"net/http"
+
+	"github.com/Masterminds/sprig/v3"
+)
+
+//go:embed templates/*.html
+var templateFS embed.FS
+
+var templates = template.Must(template.New("").Funcs(sprig.FuncMap()).ParseFS(templateFS, "templates/*.html"))
+
+// render executes the named template (e.g. "users-list.html") with data.
+func render(w http.ResponseWriter, name string, data any) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := templates.ExecuteTemplate(w, name, data); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
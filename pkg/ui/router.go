@@ -0,0 +1,43 @@
+package ui
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/gorilla/csrf"
+
+	"github.com/MarkoAnn/interfaceCapybara/pkg/auth"
+	"github.com/MarkoAnn/interfaceCapybara/pkg/domain"
+)
+
+// NewRouter builds the server-rendered admin UI. It is a separate mux from
+// handlers.NewRouter's JSON API so the caller can mount (or omit) either
+// independently of the other. csrfKey must be 32 stable random bytes kept
+// across restarts, or outstanding CSRF cookies are invalidated.
+//
+// This assumes the server is not yet served over TLS, matching the rest of
+// the toy HTTP setup; csrf.Secure(false) should be dropped once it is.
+func NewRouter(repo domain.UserRepository, tokens *auth.TokenManager, sessions auth.SessionStore, csrfKey []byte) http.Handler {
+	r := chi.NewRouter()
+
+	r.Get("/login", LoginPageHandler(repo, tokens, sessions))
+	r.Post("/login", LoginPageHandler(repo, tokens, sessions))
+	r.Post("/logout", LogoutHandler(sessions))
+
+	r.Group(func(r chi.Router) {
+		r.Use(requireSession(tokens, sessions))
+
+		r.Get("/users/new", NewUserFormPage)
+		r.Post("/users", CreateUserPage(repo))
+		r.Get("/users/{id}", UserInfoPage(repo))
+		r.Get("/users/{id}/edit", EditUserFormPage(repo))
+		r.Post("/users/{id}", UpdateUserPage(repo))
+
+		r.Group(func(r chi.Router) {
+			r.Use(auth.RequireAdmin)
+			r.Get("/users", ListUsersPage(repo))
+		})
+	})
+
+	return csrf.Protect(csrfKey, csrf.Secure(false))(r)
+}
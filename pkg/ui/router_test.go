@@ -0,0 +1,121 @@
+package ui
+
+import (
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/MarkoAnn/interfaceCapybara/pkg/auth"
+	"github.com/MarkoAnn/interfaceCapybara/pkg/domain"
+	"github.com/MarkoAnn/interfaceCapybara/pkg/repository"
+)
+
+var csrfFieldValue = regexp.MustCompile(`name="gorilla.csrf.Token" value="([^"]+)"`)
+
+func csrfToken(t *testing.T, body string) string {
+	t.Helper()
+	match := csrfFieldValue.FindStringSubmatch(body)
+	if match == nil {
+		t.Fatalf("no CSRF field found in body: %s", body)
+	}
+	return match[1]
+}
+
+func TestUIRouter_LoginAndCreateUser(t *testing.T) {
+	repo := repository.NewInMemoryUserRepository()
+	passwordHash, err := auth.HashPassword("hunter2")
+	if err != nil {
+		t.Fatalf("HashPassword() error = %v", err)
+	}
+	if err := repo.Create(domain.User{ID: "admin", Name: "Admin", Role: domain.RoleAdmin, PasswordHash: passwordHash}); err != nil {
+		t.Fatalf("repo.Create() error = %v", err)
+	}
+
+	tokens := auth.NewTokenManager([]byte("test-secret"), time.Hour)
+	sessions := auth.NewInMemorySessionStore()
+	uiRouter := NewRouter(repo, tokens, sessions, []byte("01234567890123456789012345678901"))
+	top := chi.NewRouter()
+	top.Mount("/ui", uiRouter)
+
+	server := httptest.NewServer(top)
+	defer server.Close()
+
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		t.Fatalf("cookiejar.New() error = %v", err)
+	}
+	client := &http.Client{Jar: jar}
+
+	resp, err := client.Get(server.URL + "/ui/login")
+	if err != nil {
+		t.Fatalf("GET /ui/login error = %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	token := csrfToken(t, string(body))
+
+	form := url.Values{"gorilla.csrf.Token": {token}, "id": {"admin"}, "password": {"hunter2"}}
+	resp, err = client.PostForm(server.URL+"/ui/login", form)
+	if err != nil {
+		t.Fatalf("POST /ui/login error = %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("POST /ui/login final status = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+
+	resp, err = client.Get(server.URL + "/ui/users/new")
+	if err != nil {
+		t.Fatalf("GET /ui/users/new error = %v", err)
+	}
+	body, _ = io.ReadAll(resp.Body)
+	resp.Body.Close()
+	token = csrfToken(t, string(body))
+
+	form = url.Values{
+		"gorilla.csrf.Token": {token},
+		"id":                 {"bob"},
+		"name":               {"Bob"},
+		"age":                {"22"},
+		"password":           {"swordfish"},
+	}
+	resp, err = client.PostForm(server.URL+"/ui/users", form)
+	if err != nil {
+		t.Fatalf("POST /ui/users error = %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("POST /ui/users final status = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+
+	if _, err := repo.Find("bob"); err != nil {
+		t.Fatalf("repo.Find(bob) error = %v, want user to have been created", err)
+	}
+}
+
+func TestUIRouter_RequiresSession(t *testing.T) {
+	repo := repository.NewInMemoryUserRepository()
+	tokens := auth.NewTokenManager([]byte("test-secret"), time.Hour)
+	sessions := auth.NewInMemorySessionStore()
+	uiRouter := NewRouter(repo, tokens, sessions, []byte("01234567890123456789012345678901"))
+	top := chi.NewRouter()
+	top.Mount("/ui", uiRouter)
+
+	req := httptest.NewRequest(http.MethodGet, "/ui/users/new", nil)
+	rec := httptest.NewRecorder()
+	top.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusSeeOther {
+		t.Fatalf("GET /ui/users/new without session status = %d, want %d (redirect to login)", rec.Code, http.StatusSeeOther)
+	}
+	if loc := rec.Header().Get("Location"); loc != "/ui/login" {
+		t.Fatalf("Location = %q, want %q", loc, "/ui/login")
+	}
+}
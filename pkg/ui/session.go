@@ -0,0 +1,55 @@
+package ui
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/MarkoAnn/interfaceCapybara/pkg/auth"
+)
+
+const sessionCookieName = "capybara_session"
+
+func setSessionCookie(w http.ResponseWriter, token string, expiresAt time.Time) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     sessionCookieName,
+		Value:    token,
+		Path:     "/",
+		Expires:  expiresAt,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+func clearSessionCookie(w http.ResponseWriter) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     sessionCookieName,
+		Value:    "",
+		Path:     "/",
+		MaxAge:   -1,
+		HttpOnly: true,
+	})
+}
+
+// requireSession validates the capybara_session cookie the same way
+// auth.RequireAuth validates a bearer token, redirecting to /ui/login
+// instead of returning a 401 when it's missing or invalid.
+func requireSession(tokens *auth.TokenManager, sessions auth.SessionStore) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			cookie, err := r.Cookie(sessionCookieName)
+			if err != nil {
+				http.Redirect(w, r, "/ui/login", http.StatusSeeOther)
+				return
+			}
+
+			identity, err := auth.ValidateToken(tokens, sessions, cookie.Value)
+			if err != nil {
+				clearSessionCookie(w)
+				http.Redirect(w, r, "/ui/login", http.StatusSeeOther)
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(auth.WithIdentity(r.Context(), identity)))
+		})
+	}
+}
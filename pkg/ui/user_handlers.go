@@ -0,0 +1,166 @@
+package ui
+
+import (
+	"html/template"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/gorilla/csrf"
+
+	"github.com/MarkoAnn/interfaceCapybara/pkg/auth"
+	"github.com/MarkoAnn/interfaceCapybara/pkg/domain"
+)
+
+type usersListData struct {
+	Users     []domain.User
+	CSRFField template.HTML
+}
+
+// ListUsersPage renders every user, mirroring GET /users on the JSON API.
+func ListUsersPage(repo domain.UserRepository) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		users, err := repo.List()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		render(w, "users-list.html", usersListData{Users: users, CSRFField: csrf.TemplateField(r)})
+	}
+}
+
+type userInfoData struct {
+	User      domain.User
+	CSRFField template.HTML
+}
+
+// UserInfoPage renders a single user, mirroring GET /users/{id}.
+func UserInfoPage(repo domain.UserRepository) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id := chi.URLParam(r, "id")
+		if !allowedToAccess(r, id) {
+			http.Error(w, "forbidden", http.StatusForbidden)
+			return
+		}
+
+		user, err := repo.Find(id)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		render(w, "user-info.html", userInfoData{User: user, CSRFField: csrf.TemplateField(r)})
+	}
+}
+
+type userFormData struct {
+	IsNew     bool
+	User      domain.User
+	CSRFField template.HTML
+}
+
+// NewUserFormPage renders a blank user-form.html for creating a user.
+func NewUserFormPage(w http.ResponseWriter, r *http.Request) {
+	render(w, "user-form.html", userFormData{IsNew: true, CSRFField: csrf.TemplateField(r)})
+}
+
+// CreateUserPage handles the POST from NewUserFormPage, mirroring
+// handlers.CreateUserHandler.
+func CreateUserPage(repo domain.UserRepository) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		age, err := strconv.Atoi(r.FormValue("age"))
+		if err != nil {
+			http.Error(w, "age must be a number", http.StatusBadRequest)
+			return
+		}
+		passwordHash, err := auth.HashPassword(r.FormValue("password"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		user := domain.User{
+			ID:           r.FormValue("id"),
+			Name:         r.FormValue("name"),
+			Age:          age,
+			Role:         domain.RoleUser,
+			PasswordHash: passwordHash,
+		}
+		if err := repo.Create(user); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		http.Redirect(w, r, "/ui/users/"+user.ID, http.StatusSeeOther)
+	}
+}
+
+// EditUserFormPage renders user-form.html pre-filled with the existing
+// user, for editing.
+func EditUserFormPage(repo domain.UserRepository) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id := chi.URLParam(r, "id")
+		if !allowedToAccess(r, id) {
+			http.Error(w, "forbidden", http.StatusForbidden)
+			return
+		}
+
+		user, err := repo.Find(id)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		render(w, "user-form.html", userFormData{User: user, CSRFField: csrf.TemplateField(r)})
+	}
+}
+
+// UpdateUserPage handles the POST from EditUserFormPage, mirroring
+// handlers.UpdateUserHandler.
+func UpdateUserPage(repo domain.UserRepository) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id := chi.URLParam(r, "id")
+		if !allowedToAccess(r, id) {
+			http.Error(w, "forbidden", http.StatusForbidden)
+			return
+		}
+
+		existing, err := repo.Find(id)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		age, err := strconv.Atoi(r.FormValue("age"))
+		if err != nil {
+			http.Error(w, "age must be a number", http.StatusBadRequest)
+			return
+		}
+		existing.Name = r.FormValue("name")
+		existing.Age = age
+
+		if err := repo.Update(existing); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		http.Redirect(w, r, "/ui/users/"+existing.ID, http.StatusSeeOther)
+	}
+}
+
+// allowedToAccess mirrors handlers.allowedToAccess: admins may access any
+// record, everyone else only their own.
+func allowedToAccess(r *http.Request, id string) bool {
+	identity, ok := auth.IdentityFromContext(r.Context())
+	if !ok {
+		return false
+	}
+	return identity.Role == domain.RoleAdmin || identity.UserID == id
+}